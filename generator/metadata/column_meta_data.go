@@ -2,10 +2,13 @@ package metadata
 
 // Column struct
 type Column struct {
-	Name         string
-	IsPrimaryKey bool
-	IsNullable   bool
-	DataType     DataType
+	Name         string   `json:"name" yaml:"name"`
+	IsPrimaryKey bool     `json:"isPrimaryKey,omitempty" yaml:"isPrimaryKey,omitempty"`
+	IsNullable   bool     `json:"isNullable,omitempty" yaml:"isNullable,omitempty"`
+	IsGenerated  bool     `json:"isGenerated,omitempty" yaml:"isGenerated,omitempty"`
+	HasDefault   bool     `json:"hasDefault,omitempty" yaml:"hasDefault,omitempty"`
+	Comment      string   `json:"comment,omitempty" yaml:"comment,omitempty"`
+	DataType     DataType `json:"dataType" yaml:"dataType"`
 }
 
 // DataTypeKind is database type kind(base, enum, user-defined, array)
@@ -21,7 +24,7 @@ const (
 
 // DataType contains information about column data type
 type DataType struct {
-	Name       string
-	Kind       DataTypeKind
-	IsUnsigned bool
+	Name       string       `json:"name" yaml:"name"`
+	Kind       DataTypeKind `json:"kind" yaml:"kind"`
+	IsUnsigned bool         `json:"isUnsigned,omitempty" yaml:"isUnsigned,omitempty"`
 }
@@ -18,6 +18,7 @@ const (
 type DialectQuerySet interface {
 	GetTablesMetaData(db *sql.DB, schemaName string, tableType TableType) []Table
 	GetEnumsMetaData(db *sql.DB, schemaName string) []Enum
+	GetForeignKeysMetaData(db *sql.DB, schemaName string, tableName string) []ForeignKey
 }
 
 // GetSchema retrieves Schema information from database
@@ -34,3 +35,18 @@ func GetSchema(db *sql.DB, querySet DialectQuerySet, schemaName string) Schema {
 
 	return ret
 }
+
+// GetSnapshot retrieves Schema information, including table foreign keys, from database into portable schema snapshot
+func GetSnapshot(db *sql.DB, querySet DialectQuerySet, dialect, database, schemaName string) Snapshot {
+	schema := GetSchema(db, querySet, schemaName)
+
+	for i, table := range schema.TablesMetaData {
+		schema.TablesMetaData[i].ForeignKeys = querySet.GetForeignKeysMetaData(db, schemaName, table.Name)
+	}
+
+	return Snapshot{
+		Dialect:  dialect,
+		Database: database,
+		Schema:   schema,
+	}
+}
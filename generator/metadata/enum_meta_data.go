@@ -2,6 +2,6 @@ package metadata
 
 // Enum metadata struct
 type Enum struct {
-	Name   string `sql:"primary_key"`
-	Values []string
+	Name   string   `sql:"primary_key" json:"name" yaml:"name"`
+	Values []string `json:"values" yaml:"values"`
 }
@@ -2,10 +2,10 @@ package metadata
 
 // Schema struct
 type Schema struct {
-	Name           string
-	TablesMetaData []Table
-	ViewsMetaData  []Table
-	EnumsMetaData  []Enum
+	Name           string  `json:"name" yaml:"name"`
+	TablesMetaData []Table `json:"tables,omitempty" yaml:"tables,omitempty"`
+	ViewsMetaData  []Table `json:"views,omitempty" yaml:"views,omitempty"`
+	EnumsMetaData  []Enum  `json:"enums,omitempty" yaml:"enums,omitempty"`
 }
 
 // IsEmpty returns true if schema info does not contain any table, views or enums metadata
@@ -0,0 +1,159 @@
+package metadata
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/internal/utils/throw"
+	"strings"
+)
+
+// SeedTable is reference table (statuses, roles...) whose rows are read from database, to generate Go constants
+// keyed by natural key column
+type SeedTable struct {
+	Name string
+	// KeyColumn is natural key column, whose values are used as constant names. If not set, first text column
+	// which is not part of the primary key is used.
+	KeyColumn string
+}
+
+// SeedRow is a single reference table row, with natural key column value and primary key column value
+type SeedRow struct {
+	Key   string `json:"key" yaml:"key"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// ParseSeedTables parses comma-separated list of seed tables, in the format: table_name[.key_column]
+func ParseSeedTables(list string) []SeedTable {
+	var ret []SeedTable
+
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.SplitN(entry, ".", 2)
+		seedTable := SeedTable{Name: parts[0]}
+
+		if len(parts) > 1 {
+			seedTable.KeyColumn = parts[1]
+		}
+
+		ret = append(ret, seedTable)
+	}
+
+	return ret
+}
+
+// GetSeedRows reads key and primary key column values of seed tables rows into schema tables metadata
+func GetSeedRows(db *sql.DB, dialect jet.Dialect, schema *Schema, seedTables []SeedTable) {
+	for _, seedTable := range seedTables {
+		table := schema.findTable(seedTable.Name)
+
+		if table == nil {
+			panic(fmt.Sprintf("seed constants table '%s' not found", seedTable.Name))
+		}
+
+		keyColumn, valueColumn := table.seedColumns(seedTable.KeyColumn)
+
+		query := fmt.Sprintf("SELECT %s, %s FROM %s ORDER BY %s",
+			quoteIdentifier(dialect, keyColumn),
+			quoteIdentifier(dialect, valueColumn),
+			qualifiedTableName(dialect, schema.Name, table.Name),
+			quoteIdentifier(dialect, valueColumn),
+		)
+
+		fmt.Println("Retrieving seed rows of table:", table.Name)
+		table.SeedRows = querySeedRows(db, query)
+	}
+}
+
+func querySeedRows(db *sql.DB, query string) []SeedRow {
+	rows, err := db.Query(query)
+	throw.OnError(err)
+	defer rows.Close()
+
+	var ret []SeedRow
+
+	for rows.Next() {
+		var key, value sql.NullString
+
+		throw.OnError(rows.Scan(&key, &value))
+
+		if !key.Valid || !value.Valid {
+			continue
+		}
+
+		ret = append(ret, SeedRow{Key: key.String, Value: value.String})
+	}
+
+	throw.OnError(rows.Err())
+
+	return ret
+}
+
+func (s *Schema) findTable(name string) *Table {
+	for i := range s.TablesMetaData {
+		if strings.EqualFold(s.TablesMetaData[i].Name, name) {
+			return &s.TablesMetaData[i]
+		}
+	}
+
+	return nil
+}
+
+// seedColumns returns key column and single primary key column names of the seed table
+func (t Table) seedColumns(keyColumn string) (string, string) {
+	primaryKeys := t.PrimaryKeyColumns()
+
+	if len(primaryKeys) != 1 {
+		panic(fmt.Sprintf("seed constants table '%s' should have single column primary key", t.Name))
+	}
+
+	for _, column := range t.Columns {
+		if column.IsPrimaryKey {
+			continue
+		}
+
+		if keyColumn == "" && isTextDataType(column.DataType) || strings.EqualFold(column.Name, keyColumn) {
+			return column.Name, primaryKeys[0].Name
+		}
+	}
+
+	if keyColumn == "" {
+		panic(fmt.Sprintf("seed constants table '%s' does not have text key column", t.Name))
+	}
+
+	panic(fmt.Sprintf("seed constants key column '%s' not found in table '%s'", keyColumn, t.Name))
+}
+
+func isTextDataType(dataType DataType) bool {
+	if dataType.Kind != BaseType && dataType.Kind != EnumType {
+		return false
+	}
+
+	typeName := strings.SplitN(dataType.Name, "(", 2)[0] // strip type modifiers, like in varchar(20)
+
+	switch strings.ToLower(strings.TrimSpace(typeName)) {
+	case "text", "character varying", "varchar", "character", "char", "bpchar", "tinytext", "mediumtext", "longtext":
+		return true
+	}
+
+	return dataType.Kind == EnumType
+}
+
+func quoteIdentifier(dialect jet.Dialect, name string) string {
+	quoteChar := string(dialect.IdentifierQuoteChar())
+
+	return quoteChar + strings.Replace(name, quoteChar, quoteChar+quoteChar, -1) + quoteChar
+}
+
+func qualifiedTableName(dialect jet.Dialect, schemaName, tableName string) string {
+	if schemaName == "" {
+		return quoteIdentifier(dialect, tableName)
+	}
+
+	return quoteIdentifier(dialect, schemaName) + "." + quoteIdentifier(dialect, tableName)
+}
@@ -0,0 +1,120 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Snapshot is portable database schema snapshot, from which jet files can be generated without database connection
+type Snapshot struct {
+	// Dialect is dialect package name of snapshot database (postgres, mysql or sqlite)
+	Dialect string `json:"dialect" yaml:"dialect"`
+	// Database is database name, used as destination directory for generated files. Empty for MySQL and SQLite.
+	Database string `json:"database,omitempty" yaml:"database,omitempty"`
+	Schema   Schema `json:"schema" yaml:"schema"`
+}
+
+// ReadSnapshot reads schema snapshot from JSON or YAML file. Format is detected from file extension,
+// files with .yaml or .yml extension are parsed as YAML, all the others as JSON.
+func ReadSnapshot(filePath string) (Snapshot, error) {
+	var snapshot Snapshot
+
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return snapshot, fmt.Errorf("failed to read snapshot file, %w", err)
+	}
+
+	if isYAMLFile(filePath) {
+		err = yaml.Unmarshal(data, &snapshot)
+	} else {
+		err = json.Unmarshal(data, &snapshot)
+	}
+
+	if err != nil {
+		return snapshot, fmt.Errorf("failed to parse snapshot file %s, %w", filePath, err)
+	}
+
+	return snapshot, nil
+}
+
+// WriteSnapshot writes schema snapshot to JSON or YAML file, depending on file extension. Tables, views, enums and
+// foreign keys are sorted by name, so that the same schema always produces the same file.
+func WriteSnapshot(filePath string, snapshot Snapshot) error {
+	sortSnapshot(&snapshot)
+
+	var data []byte
+	var err error
+
+	if isYAMLFile(filePath) {
+		var buff bytes.Buffer
+		encoder := yaml.NewEncoder(&buff)
+		encoder.SetIndent(2)
+		err = encoder.Encode(snapshot)
+		if err == nil {
+			err = encoder.Close()
+		}
+		data = buff.Bytes()
+	} else {
+		data, err = json.MarshalIndent(snapshot, "", "  ")
+		data = append(data, '\n')
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot, %w", err)
+	}
+
+	err = ioutil.WriteFile(filePath, data, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to write snapshot file, %w", err)
+	}
+
+	return nil
+}
+
+// sortSnapshot sorts snapshot tables, views, enums and foreign keys by name. Column and enum value order
+// is not changed, because it matches database definition order.
+func sortSnapshot(snapshot *Snapshot) {
+	sortTables := func(tables []Table) []Table {
+		ret := append([]Table{}, tables...)
+
+		sort.SliceStable(ret, func(i, j int) bool {
+			return ret[i].Name < ret[j].Name
+		})
+
+		for i := range ret {
+			foreignKeys := append([]ForeignKey{}, ret[i].ForeignKeys...)
+			sort.SliceStable(foreignKeys, func(i, j int) bool {
+				return foreignKeys[i].Name < foreignKeys[j].Name
+			})
+			if len(foreignKeys) > 0 {
+				ret[i].ForeignKeys = foreignKeys
+			}
+		}
+
+		return ret
+	}
+
+	snapshot.Schema.TablesMetaData = sortTables(snapshot.Schema.TablesMetaData)
+	snapshot.Schema.ViewsMetaData = sortTables(snapshot.Schema.ViewsMetaData)
+
+	enums := append([]Enum{}, snapshot.Schema.EnumsMetaData...)
+	sort.SliceStable(enums, func(i, j int) bool {
+		return enums[i].Name < enums[j].Name
+	})
+	snapshot.Schema.EnumsMetaData = enums
+}
+
+func isYAMLFile(filePath string) bool {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".yaml", ".yml":
+		return true
+	}
+
+	return false
+}
@@ -2,16 +2,55 @@ package metadata
 
 // Table metadata struct
 type Table struct {
-	Name    string
-	Columns []Column
+	Name        string       `json:"name" yaml:"name"`
+	Columns     []Column     `json:"columns" yaml:"columns"`
+	ForeignKeys []ForeignKey `json:"foreignKeys,omitempty" yaml:"foreignKeys,omitempty"`
+	// SeedRows are reference table rows, used to generate Go constants keyed by natural key column
+	SeedRows []SeedRow `json:"seedRows,omitempty" yaml:"seedRows,omitempty"`
 }
 
-// MutableColumns returns list of mutable columns for table
+// ForeignKey metadata struct
+type ForeignKey struct {
+	Name              string   `json:"name" yaml:"name"`
+	Columns           []string `json:"columns" yaml:"columns"`
+	ReferencedTable   string   `json:"referencedTable" yaml:"referencedTable"`
+	ReferencedColumns []string `json:"referencedColumns" yaml:"referencedColumns"`
+}
+
+// ForeignKeyColumn is a single column pair of foreign key, as retrieved from database
+type ForeignKeyColumn struct {
+	Name             string
+	Column           string
+	ReferencedTable  string
+	ReferencedColumn string
+}
+
+// NewForeignKeys groups consecutive foreign key column pairs with the same name into foreign keys
+func NewForeignKeys(columns []ForeignKeyColumn) []ForeignKey {
+	var ret []ForeignKey
+
+	for _, column := range columns {
+		if len(ret) == 0 || ret[len(ret)-1].Name != column.Name {
+			ret = append(ret, ForeignKey{
+				Name:            column.Name,
+				ReferencedTable: column.ReferencedTable,
+			})
+		}
+
+		foreignKey := &ret[len(ret)-1]
+		foreignKey.Columns = append(foreignKey.Columns, column.Column)
+		foreignKey.ReferencedColumns = append(foreignKey.ReferencedColumns, column.ReferencedColumn)
+	}
+
+	return ret
+}
+
+// MutableColumns returns list of mutable columns for table. Primary key and generated columns are not mutable.
 func (t Table) MutableColumns() []Column {
 	var ret []Column
 
 	for _, column := range t.Columns {
-		if column.IsPrimaryKey {
+		if column.IsPrimaryKey || column.IsGenerated {
 			continue
 		}
 
@@ -20,3 +59,30 @@ func (t Table) MutableColumns() []Column {
 
 	return ret
 }
+
+// PrimaryKeyColumns returns list of primary key columns for table
+func (t Table) PrimaryKeyColumns() []Column {
+	var ret []Column
+
+	for _, column := range t.Columns {
+		if column.IsPrimaryKey {
+			ret = append(ret, column)
+		}
+	}
+
+	return ret
+}
+
+// PrimaryKeyColumnsWithDefault returns list of primary key columns whose values are generated by database default,
+// for instance serial or uuid DEFAULT gen_random_uuid() primary keys.
+func (t Table) PrimaryKeyColumnsWithDefault() []Column {
+	var ret []Column
+
+	for _, column := range t.PrimaryKeyColumns() {
+		if column.HasDefault || column.IsGenerated {
+			ret = append(ret, column)
+		}
+	}
+
+	return ret
+}
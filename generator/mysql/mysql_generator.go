@@ -67,6 +67,34 @@ func GenerateDSN(dsn, destDir string, templates ...template.Template) (err error
 	return nil
 }
 
+// DumpDSN retrieves database information using dsn connection string and writes it to JSON or YAML schema snapshot file.
+// Rows of seedTables are dumped as well, so that model constants can be generated from the snapshot.
+func DumpDSN(dsn, snapshotFilePath string, seedTables ...metadata.SeedTable) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	idx := strings.Index(dsn, "://")
+	if idx != -1 {
+		dsn = dsn[idx+len("://"):]
+	}
+
+	cfg, err := mysqldr.ParseDSN(dsn)
+	throw.OnError(err)
+	if cfg.DBName == "" {
+		panic("database name is required")
+	}
+
+	db := openConnection(dsn)
+	defer utils.DBClose(db)
+
+	fmt.Println("Retrieving database information...")
+	// No schemas in MySQL
+	snapshot := metadata.GetSnapshot(db, &mySqlQuerySet{}, mysql.Dialect.PackageName(), "", cfg.DBName)
+	metadata.GetSeedRows(db, mysql.Dialect, &snapshot.Schema, seedTables)
+
+	fmt.Println("Writing schema snapshot:", snapshotFilePath)
+	return metadata.WriteSnapshot(snapshotFilePath, snapshot)
+}
+
 func openConnection(connectionString string) *sql.DB {
 	fmt.Println("Connecting to MySQL database: " + connectionString)
 	db, err := sql.Open("mysql", connectionString)
@@ -88,5 +116,7 @@ func generate(db *sql.DB, dbName, destDir string, templates ...template.Template
 		genTemplate = templates[0]
 	}
 
+	metadata.GetSeedRows(db, mysql.Dialect, &schemaMetaData, genTemplate.SeedTables)
+
 	template.ProcessSchema(destDir, schemaMetaData, genTemplate)
 }
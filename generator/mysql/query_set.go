@@ -40,6 +40,9 @@ SELECT COLUMN_NAME AS "column.Name",
 			JOIN information_schema.key_column_usage k USING(constraint_name,table_schema,table_name)
 		WHERE table_schema = ? AND table_name = ? AND t.constraint_type='PRIMARY KEY' AND k.column_name = columns.column_name
 	)) AS "column.IsPrimaryKey",
+	(EXTRA LIKE '%VIRTUAL GENERATED%' OR EXTRA LIKE '%STORED GENERATED%') AS "column.IsGenerated",
+	(COLUMN_DEFAULT IS NOT NULL OR EXTRA LIKE '%auto_increment%') AS "column.HasDefault",
+	COLUMN_COMMENT AS "column.Comment",
 	IF (COLUMN_TYPE = 'tinyint(1)', 
 			'boolean', 
 			IF (DATA_TYPE='enum', 
@@ -88,3 +91,20 @@ WHERE c.table_schema = ? AND DATA_TYPE = 'enum';
 
 	return ret
 }
+
+func (m mySqlQuerySet) GetForeignKeysMetaData(db *sql.DB, schemaName string, tableName string) []metadata.ForeignKey {
+	query := `
+SELECT CONSTRAINT_NAME AS "name",
+	COLUMN_NAME AS "column",
+	REFERENCED_TABLE_NAME AS "referenced_table",
+	REFERENCED_COLUMN_NAME AS "referenced_column"
+FROM information_schema.key_column_usage
+WHERE table_schema = ? AND table_name = ? AND REFERENCED_TABLE_NAME IS NOT NULL
+ORDER BY CONSTRAINT_NAME, ORDINAL_POSITION;
+`
+	var columns []metadata.ForeignKeyColumn
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName}, &columns)
+	throw.OnError(err)
+
+	return metadata.NewForeignKeys(columns)
+}
@@ -61,6 +61,7 @@ func GenerateDSN(dsn, schema, destDir string, templates ...template.Template) (e
 	}
 
 	schemaMetadata := metadata.GetSchema(db, &postgresQuerySet{}, schema)
+	metadata.GetSeedRows(db, postgres.Dialect, &schemaMetadata, generatorTemplate.SeedTables)
 
 	dirPath := path.Join(destDir, cfg.Database)
 
@@ -68,6 +69,27 @@ func GenerateDSN(dsn, schema, destDir string, templates ...template.Template) (e
 	return
 }
 
+// DumpDSN retrieves schema information using dsn connection string and writes it to JSON or YAML schema snapshot file.
+// Rows of seedTables are dumped as well, so that model constants can be generated from the snapshot.
+func DumpDSN(dsn, schema, snapshotFilePath string, seedTables ...metadata.SeedTable) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	cfg, err := pgconn.ParseConfig(dsn)
+	throw.OnError(err)
+	if cfg.Database == "" {
+		panic("database name is required")
+	}
+	db := openConnection(dsn)
+	defer utils.DBClose(db)
+
+	fmt.Println("Retrieving schema information...")
+	snapshot := metadata.GetSnapshot(db, &postgresQuerySet{}, postgres.Dialect.PackageName(), cfg.Database, schema)
+	metadata.GetSeedRows(db, postgres.Dialect, &snapshot.Schema, seedTables)
+
+	fmt.Println("Writing schema snapshot:", snapshotFilePath)
+	return metadata.WriteSnapshot(snapshotFilePath, snapshot)
+}
+
 func openConnection(dsn string) *sql.DB {
 	fmt.Println("Connecting to postgres database: " + dsn)
 
@@ -41,6 +41,9 @@ WITH primaryKeys AS (
 SELECT column_name as "column.Name", 
 	   is_nullable = 'YES' as "column.isNullable",
        (EXISTS(SELECT 1 from primaryKeys as pk where pk.column_name = columns.column_name)) as "column.IsPrimaryKey",
+       (COALESCE(identity_generation, '') = 'ALWAYS' OR is_generated = 'ALWAYS') as "column.IsGenerated",
+       (column_default IS NOT NULL OR identity_generation IS NOT NULL) as "column.HasDefault",
+       COALESCE(col_description(format('%I.%I', table_schema, table_name)::regclass, ordinal_position), '') as "column.Comment",
 	   dataType.kind as "dataType.Kind",	
 	   (case dataType.Kind when 'base' then data_type else LTRIM(udt_name, '_') end) as "dataType.Name", 
 	   FALSE as "dataType.isUnsigned"
@@ -81,3 +84,26 @@ ORDER BY n.nspname, t.typname, e.enumsortorder;`
 
 	return result
 }
+
+func (p postgresQuerySet) GetForeignKeysMetaData(db *sql.DB, schemaName string, tableName string) []metadata.ForeignKey {
+	query := `
+SELECT con.conname as "name",
+	   col.attname as "column",
+	   refTable.relname as "referenced_table",
+	   refCol.attname as "referenced_column"
+FROM pg_catalog.pg_constraint con
+	JOIN pg_catalog.pg_class tab ON tab.oid = con.conrelid
+	JOIN pg_catalog.pg_namespace n ON n.oid = tab.relnamespace
+	JOIN pg_catalog.pg_class refTable ON refTable.oid = con.confrelid
+	CROSS JOIN LATERAL unnest(con.conkey, con.confkey) WITH ORDINALITY AS k(attnum, refattnum, position)
+	JOIN pg_catalog.pg_attribute col ON col.attrelid = con.conrelid AND col.attnum = k.attnum
+	JOIN pg_catalog.pg_attribute refCol ON refCol.attrelid = con.confrelid AND refCol.attnum = k.refattnum
+WHERE con.contype = 'f' AND n.nspname = $1 AND tab.relname = $2
+ORDER BY con.conname, k.position;
+`
+	var columns []metadata.ForeignKeyColumn
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName}, &columns)
+	throw.OnError(err)
+
+	return metadata.NewForeignKeys(columns)
+}
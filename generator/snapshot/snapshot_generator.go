@@ -0,0 +1,57 @@
+package snapshot
+
+import (
+	"fmt"
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/generator/template"
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/internal/utils"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/sqlite"
+	"path"
+)
+
+// Dialect returns jet dialect of the snapshot database
+func Dialect(snapshot metadata.Snapshot) (jet.Dialect, error) {
+	switch snapshot.Dialect {
+	case postgres.Dialect.PackageName():
+		return postgres.Dialect, nil
+	case mysql.Dialect.PackageName():
+		return mysql.Dialect, nil
+	case sqlite.Dialect.PackageName():
+		return sqlite.Dialect, nil
+	}
+
+	return nil, fmt.Errorf("unsupported snapshot dialect '%s', only postgres, mysql and sqlite are supported", snapshot.Dialect)
+}
+
+// GenerateFile generates jet files at destination dir from schema snapshot file
+func GenerateFile(snapshotFilePath, destDir string, templates ...template.Template) error {
+	snapshot, err := metadata.ReadSnapshot(snapshotFilePath)
+	if err != nil {
+		return err
+	}
+
+	return Generate(destDir, snapshot, templates...)
+}
+
+// Generate generates jet files at destination dir from schema snapshot, without connecting to database
+func Generate(destDir string, snapshot metadata.Snapshot, templates ...template.Template) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	dialect, err := Dialect(snapshot)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Generating from schema snapshot...")
+
+	generatorTemplate := template.Default(dialect)
+	if len(templates) > 0 {
+		generatorTemplate = templates[0]
+	}
+
+	template.ProcessSchema(path.Join(destDir, snapshot.Database), snapshot.Schema, generatorTemplate)
+	return
+}
@@ -0,0 +1,134 @@
+package snapshot
+
+import (
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+var jsonSnapshot = `{
+  "dialect": "postgres",
+  "database": "jetdb",
+  "schema": {
+    "name": "dvds",
+    "tables": [
+      {
+        "name": "film",
+        "columns": [
+          {"name": "film_id", "isPrimaryKey": true, "hasDefault": true, "dataType": {"name": "integer", "kind": "base"}},
+          {"name": "title", "dataType": {"name": "text", "kind": "base"}},
+          {"name": "language_id", "dataType": {"name": "smallint", "kind": "base"}},
+          {"name": "rating", "isNullable": true, "dataType": {"name": "mpaa_rating", "kind": "enum"}}
+        ],
+        "foreignKeys": [
+          {"name": "film_language_id_fkey", "columns": ["language_id"], "referencedTable": "language", "referencedColumns": ["language_id"]}
+        ]
+      }
+    ],
+    "enums": [
+      {"name": "mpaa_rating", "values": ["G", "PG"]}
+    ]
+  }
+}`
+
+var yamlSnapshot = `
+dialect: mysql
+schema:
+  name: dvds
+  tables:
+    - name: film
+      columns:
+        - name: film_id
+          isPrimaryKey: true
+          hasDefault: true
+          dataType:
+            name: smallint
+            kind: base
+            isUnsigned: true
+        - name: title
+          dataType:
+            name: varchar
+            kind: base
+`
+
+func TestReadSnapshot(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet-snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	jsonFile := path.Join(dirPath, "dvds.json")
+	require.NoError(t, ioutil.WriteFile(jsonFile, []byte(jsonSnapshot), 0644))
+
+	snapshot, err := metadata.ReadSnapshot(jsonFile)
+	require.NoError(t, err)
+	require.Equal(t, "postgres", snapshot.Dialect)
+	require.Equal(t, "jetdb", snapshot.Database)
+	require.Equal(t, "dvds", snapshot.Schema.Name)
+	require.Len(t, snapshot.Schema.TablesMetaData, 1)
+	require.Equal(t, metadata.Column{
+		Name:       "rating",
+		IsNullable: true,
+		DataType:   metadata.DataType{Name: "mpaa_rating", Kind: metadata.EnumType},
+	}, snapshot.Schema.TablesMetaData[0].Columns[3])
+	require.Equal(t, []metadata.ForeignKey{
+		{
+			Name:              "film_language_id_fkey",
+			Columns:           []string{"language_id"},
+			ReferencedTable:   "language",
+			ReferencedColumns: []string{"language_id"},
+		},
+	}, snapshot.Schema.TablesMetaData[0].ForeignKeys)
+	require.Equal(t, []metadata.Enum{{Name: "mpaa_rating", Values: []string{"G", "PG"}}}, snapshot.Schema.EnumsMetaData)
+
+	yamlFile := path.Join(dirPath, "dvds.yaml")
+	require.NoError(t, ioutil.WriteFile(yamlFile, []byte(yamlSnapshot), 0644))
+
+	snapshot, err = metadata.ReadSnapshot(yamlFile)
+	require.NoError(t, err)
+	require.Equal(t, "mysql", snapshot.Dialect)
+	require.Equal(t, metadata.Column{
+		Name:         "film_id",
+		IsPrimaryKey: true,
+		HasDefault:   true,
+		DataType:     metadata.DataType{Name: "smallint", Kind: metadata.BaseType, IsUnsigned: true},
+	}, snapshot.Schema.TablesMetaData[0].Columns[0])
+
+	_, err = metadata.ReadSnapshot(path.Join(dirPath, "missing.json"))
+	require.Error(t, err)
+}
+
+func TestGenerateFile(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet-snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	jsonFile := path.Join(dirPath, "dvds.json")
+	require.NoError(t, ioutil.WriteFile(jsonFile, []byte(jsonSnapshot), 0644))
+
+	genDir := path.Join(dirPath, "gen")
+	require.NoError(t, GenerateFile(jsonFile, genDir))
+
+	film, err := ioutil.ReadFile(path.Join(genDir, "jetdb", "dvds", "model", "film.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(film), `
+type Film struct {
+	FilmID     int32 `+"`sql:\"primary_key\"`"+`
+	Title      string
+	LanguageID int16
+	Rating     *MpaaRating
+}
+`)
+
+	filmTable, err := ioutil.ReadFile(path.Join(genDir, "jetdb", "dvds", "table", "film.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(filmTable), `var Film = newFilmTable("dvds", "film", "")`)
+
+	_, err = os.Stat(path.Join(genDir, "jetdb", "dvds", "enum", "mpaa_rating.go"))
+	require.NoError(t, err)
+
+	err = Generate(genDir, metadata.Snapshot{Dialect: "oracle"})
+	require.EqualError(t, err, "unsupported snapshot dialect 'oracle', only postgres, mysql and sqlite are supported")
+}
@@ -39,12 +39,14 @@ func (p sqliteQuerySet) GetTablesMetaData(db *sql.DB, schemaName string, tableTy
 }
 
 func (p sqliteQuerySet) GetTableColumnsMetaData(db *sql.DB, schemaName string, tableName string) []metadata.Column {
-	query := fmt.Sprintf(`select * from pragma_table_info(?);`)
+	query := fmt.Sprintf(`select * from pragma_table_xinfo(?);`)
 	var columnInfos []struct {
-		Name    string
-		Type    string
-		NotNull int32
-		Pk      int32
+		Name      string
+		Type      string
+		NotNull   int32
+		DfltValue *string
+		Pk        int32
+		Hidden    int32 // 1 - hidden virtual table column, 2 - virtual generated column, 3 - stored generated column
 	}
 
 	_, err := qrm.Query(context.Background(), db, query, []interface{}{tableName}, &columnInfos)
@@ -53,12 +55,18 @@ func (p sqliteQuerySet) GetTableColumnsMetaData(db *sql.DB, schemaName string, t
 	var columns []metadata.Column
 
 	for _, columnInfo := range columnInfos {
+		if columnInfo.Hidden == 1 {
+			continue
+		}
+
 		columnType := getColumnType(columnInfo.Type)
 
 		columns = append(columns, metadata.Column{
 			Name:         columnInfo.Name,
 			IsPrimaryKey: columnInfo.Pk != 0,
 			IsNullable:   columnInfo.NotNull != 1,
+			IsGenerated:  columnInfo.Hidden == 2 || columnInfo.Hidden == 3,
+			HasDefault:   columnInfo.DfltValue != nil,
 			DataType: metadata.DataType{
 				Name:       columnType,
 				Kind:       metadata.BaseType,
@@ -78,3 +86,61 @@ func getColumnType(columnType string) string {
 func (p sqliteQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) []metadata.Enum {
 	return nil
 }
+
+// GetForeignKeysMetaData returns table foreign keys. SQLite does not report foreign key constraint names,
+// so foreign keys are named by table name and foreign key id.
+func (p sqliteQuerySet) GetForeignKeysMetaData(db *sql.DB, schemaName string, tableName string) []metadata.ForeignKey {
+	query := `select * from pragma_foreign_key_list(?) order by id, seq;`
+	var foreignKeyInfos []struct {
+		ID    int32
+		Seq   int32
+		Table string
+		From  string
+		To    *string
+	}
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{tableName}, &foreignKeyInfos)
+	throw.OnError(err)
+
+	var columns []metadata.ForeignKeyColumn
+
+	for _, foreignKeyInfo := range foreignKeyInfos {
+		columns = append(columns, metadata.ForeignKeyColumn{
+			Name:            fmt.Sprintf("%s_fk_%d", tableName, foreignKeyInfo.ID),
+			Column:          foreignKeyInfo.From,
+			ReferencedTable: foreignKeyInfo.Table,
+		})
+
+		if foreignKeyInfo.To != nil {
+			columns[len(columns)-1].ReferencedColumn = *foreignKeyInfo.To
+		}
+	}
+
+	foreignKeys := metadata.NewForeignKeys(columns)
+
+	// foreign key without referenced columns references primary key of referenced table
+	for i, foreignKey := range foreignKeys {
+		if foreignKey.ReferencedColumns[0] == "" {
+			foreignKeys[i].ReferencedColumns = p.getPrimaryKeyColumns(db, foreignKey.ReferencedTable)
+		}
+	}
+
+	return foreignKeys
+}
+
+func (p sqliteQuerySet) getPrimaryKeyColumns(db *sql.DB, tableName string) []string {
+	query := `select name from pragma_table_info(?) where pk > 0 order by pk;`
+	var primaryKeyColumns []struct {
+		Name string
+	}
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{tableName}, &primaryKeyColumns)
+	throw.OnError(err)
+
+	var ret []string
+	for _, column := range primaryKeyColumns {
+		ret = append(ret, column.Name)
+	}
+
+	return ret
+}
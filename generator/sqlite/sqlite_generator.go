@@ -26,7 +26,25 @@ func GenerateDSN(dsn, destDir string, templates ...template.Template) (err error
 	}
 
 	schemaMetadata := metadata.GetSchema(db, &sqliteQuerySet{}, "")
+	metadata.GetSeedRows(db, sqlite.Dialect, &schemaMetadata, generatorTemplate.SeedTables)
 
 	template.ProcessSchema(destDir, schemaMetadata, generatorTemplate)
 	return
 }
+
+// DumpDSN retrieves schema information using dsn connection string and writes it to JSON or YAML schema snapshot file.
+// Rows of seedTables are dumped as well, so that model constants can be generated from the snapshot.
+func DumpDSN(dsn, snapshotFilePath string, seedTables ...metadata.SeedTable) (err error) {
+	defer utils.ErrorCatch(&err)
+
+	db, err := sql.Open("sqlite3", dsn)
+	throw.OnError(err)
+	defer utils.DBClose(db)
+
+	fmt.Println("Retrieving schema information...")
+	snapshot := metadata.GetSnapshot(db, &sqliteQuerySet{}, sqlite.Dialect.PackageName(), "", "")
+	metadata.GetSeedRows(db, sqlite.Dialect, &snapshot.Schema, seedTables)
+
+	fmt.Println("Writing schema snapshot:", snapshotFilePath)
+	return metadata.WriteSnapshot(snapshotFilePath, snapshot)
+}
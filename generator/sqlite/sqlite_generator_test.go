@@ -0,0 +1,182 @@
+package sqlite
+
+import (
+	"database/sql"
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/generator/template"
+	"github.com/go-jet/jet/v2/sqlite"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestDumpDSN(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet-sqlite-dump")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	dsn := "file:" + path.Join(dirPath, "test.db")
+
+	db, err := sql.Open("sqlite3", dsn)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+CREATE TABLE language (
+	language_id INTEGER PRIMARY KEY,
+	name VARCHAR(20) NOT NULL
+);
+CREATE TABLE film (
+	film_id INTEGER PRIMARY KEY,
+	title TEXT NOT NULL,
+	language_id INTEGER NOT NULL REFERENCES language,
+	original_language_id INTEGER REFERENCES language(language_id)
+);
+CREATE VIEW film_list AS SELECT film_id, title FROM film;
+`)
+	require.NoError(t, err)
+
+	snapshotFile := path.Join(dirPath, "snapshot.json")
+	require.NoError(t, DumpDSN(dsn, snapshotFile))
+
+	snapshot, err := metadata.ReadSnapshot(snapshotFile)
+	require.NoError(t, err)
+
+	require.Equal(t, "sqlite", snapshot.Dialect)
+	require.Len(t, snapshot.Schema.TablesMetaData, 2)
+	require.Equal(t, "film", snapshot.Schema.TablesMetaData[0].Name)
+	require.Equal(t, "language", snapshot.Schema.TablesMetaData[1].Name)
+	require.Equal(t, "film_list", snapshot.Schema.ViewsMetaData[0].Name)
+
+	film := snapshot.Schema.TablesMetaData[0]
+	require.Equal(t, metadata.Column{
+		Name:     "title",
+		DataType: metadata.DataType{Name: "TEXT", Kind: metadata.BaseType},
+	}, film.Columns[1])
+	require.Equal(t, []metadata.ForeignKey{
+		{
+			Name:              "film_fk_0",
+			Columns:           []string{"original_language_id"},
+			ReferencedTable:   "language",
+			ReferencedColumns: []string{"language_id"},
+		},
+		{
+			Name:              "film_fk_1",
+			Columns:           []string{"language_id"},
+			ReferencedTable:   "language",
+			ReferencedColumns: []string{"language_id"},
+		},
+	}, film.ForeignKeys)
+
+	// dump is deterministic
+	first, err := ioutil.ReadFile(snapshotFile)
+	require.NoError(t, err)
+	require.NoError(t, DumpDSN(dsn, snapshotFile))
+	second, err := ioutil.ReadFile(snapshotFile)
+	require.NoError(t, err)
+	require.Equal(t, string(first), string(second))
+
+	yamlSnapshotFile := path.Join(dirPath, "snapshot.yaml")
+	require.NoError(t, DumpDSN(dsn, yamlSnapshotFile))
+	yamlSnapshot, err := metadata.ReadSnapshot(yamlSnapshotFile)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, yamlSnapshot)
+}
+
+func TestGenerateDSNSeedConstants(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet-sqlite-seed")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	dsn := "file:" + path.Join(dirPath, "test.db")
+	destDir := path.Join(dirPath, "gen")
+
+	db, err := sql.Open("sqlite3", dsn)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+CREATE TABLE order_status (
+	status_id INTEGER PRIMARY KEY,
+	code VARCHAR(20) NOT NULL,
+	Description TEXT
+);
+INSERT INTO order_status VALUES (2, 'shipped', 'Order shipped'), (1, 'in progress', 'Order in progress');
+CREATE TABLE role (
+	role_id INTEGER PRIMARY KEY,
+	name TEXT
+);
+INSERT INTO role VALUES (1, 'admin'), (2, NULL);
+`)
+	require.NoError(t, err)
+
+	genTemplate := template.Default(sqlite.Dialect).UseSeedTables(metadata.ParseSeedTables("order_status.code, role")...)
+	require.NoError(t, GenerateDSN(dsn, destDir, genTemplate))
+
+	orderStatus, err := ioutil.ReadFile(path.Join(destDir, "model", "order_status.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(orderStatus), `
+const (
+	OrderStatusInProgress int32 = 1
+	OrderStatusShipped    int32 = 2
+)
+`)
+
+	role, err := ioutil.ReadFile(path.Join(destDir, "model", "role.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(role), `
+const (
+	RoleAdmin int32 = 1
+)
+`)
+
+	genTemplate = template.Default(sqlite.Dialect).UseSeedTables(metadata.SeedTable{Name: "order_status", KeyColumn: "label"})
+	require.EqualError(t, GenerateDSN(dsn, destDir, genTemplate), "seed constants key column 'label' not found in table 'order_status'")
+	genTemplate = template.Default(sqlite.Dialect).UseSeedTables(metadata.SeedTable{Name: "customer"})
+	require.EqualError(t, GenerateDSN(dsn, destDir, genTemplate), "seed constants table 'customer' not found")
+}
+
+func TestDumpDSNSeedConstants(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet-sqlite-seed-dump")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	dsn := "file:" + path.Join(dirPath, "test.db")
+
+	db, err := sql.Open("sqlite3", dsn)
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec(`
+CREATE TABLE order_status (
+	status_id INTEGER PRIMARY KEY,
+	code VARCHAR(20) NOT NULL
+);
+INSERT INTO order_status VALUES (2, 'shipped'), (1, 'in progress');
+`)
+	require.NoError(t, err)
+
+	snapshotFile := path.Join(dirPath, "snapshot.json")
+	require.NoError(t, DumpDSN(dsn, snapshotFile, metadata.ParseSeedTables("order_status.code")...))
+
+	snapshot, err := metadata.ReadSnapshot(snapshotFile)
+	require.NoError(t, err)
+	require.Equal(t, []metadata.SeedRow{{Key: "in progress", Value: "1"}, {Key: "shipped", Value: "2"}},
+		snapshot.Schema.TablesMetaData[0].SeedRows)
+
+	destDir := path.Join(dirPath, "gen")
+	template.ProcessSchema(destDir, snapshot.Schema, template.Default(sqlite.Dialect))
+
+	orderStatus, err := ioutil.ReadFile(path.Join(destDir, "model", "order_status.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(orderStatus), `
+const (
+	OrderStatusInProgress int32 = 1
+	OrderStatusShipped    int32 = 2
+)
+`)
+}
@@ -22,6 +22,9 @@ package {{package}}
 
 import (
 	"github.com/go-jet/jet/v2/{{dialect.PackageName}}"
+{{- range primaryKeyImports}}
+	"{{.}}"
+{{- end}}
 )
 
 var {{tableTemplate.InstanceName}} = new{{tableTemplate.TypeName}}("{{schemaName}}", "{{.Name}}", "")
@@ -37,7 +40,25 @@ type {{tableTemplate.TypeName}} struct {
 
 	AllColumns     {{dialect.PackageName}}.ColumnList
 	MutableColumns {{dialect.PackageName}}.ColumnList
+	DefaultColumns {{dialect.PackageName}}.ColumnList
+}
+
+{{- if not isView}}
+
+// INSERT_MODEL creates new INSERT statement for all mutable columns, with values taken from the model.
+// Model fields are matched with columns using the same rules as query result mapping.
+// Columns with database default value are omitted if corresponding model field is nil pointer.
+func (a {{tableTemplate.TypeName}}) INSERT_MODEL(model interface{}) {{dialect.PackageName}}.InsertStatement {
+	return a.INSERT(a.MutableColumns.ExceptZeroDefaults(model)).MODEL(model)
 }
+{{- if .PrimaryKeyColumns}}
+
+// InsertOrIgnore creates new INSERT IGNORE statement from the model, which skips the row if it conflicts with existing one.
+func (a {{tableTemplate.TypeName}}) InsertOrIgnore(model interface{}) {{dialect.PackageName}}.InsertStatement {
+	return a.INSERT_MODEL(model).IGNORE()
+}
+{{- end}}
+{{- end}}
 
 // AS creates new {{tableTemplate.TypeName}} with assigned alias
 func (a {{tableTemplate.TypeName}}) AS(alias string) {{tableTemplate.TypeName}} {
@@ -48,6 +69,59 @@ func (a {{tableTemplate.TypeName}}) AS(alias string) {{tableTemplate.TypeName}}
 func (a {{tableTemplate.TypeName}}) FromSchema(schemaName string) {{tableTemplate.TypeName}} {
 	return new{{tableTemplate.TypeName}}(schemaName, a.TableName(), a.Alias())
 }
+{{- if .PrimaryKeyColumns}}
+
+// PrimaryKey returns list of {{tableTemplate.TypeName}} primary key columns
+func (a {{tableTemplate.TypeName}}) PrimaryKey() {{dialect.PackageName}}.ColumnList {
+	return {{dialect.PackageName}}.ColumnList{
+	{{- range $i, $c := .PrimaryKeyColumns}}
+	{{- $field := columnField $c}}
+		{{- if gt $i 0 }}, {{end}}a.{{$field.Name}}
+	{{- end}}}
+}
+{{- end}}
+{{- with primaryKeyFields}}
+
+// {{tableTemplate.InstanceName}}PK is {{tableTemplate.TypeName}} composite primary key value
+type {{tableTemplate.InstanceName}}PK struct {
+{{- range .}}
+	{{.Name}} {{.Type.Name}}
+{{- end}}
+}
+
+// ByKey returns condition that matches {{tableTemplate.TypeName}} row by composite primary key value
+func (a {{tableTemplate.TypeName}}) ByKey(key {{tableTemplate.InstanceName}}PK) {{dialect.PackageName}}.BoolExpression {
+	return {{range $i, $f := .}}
+	{{- if gt $i 0}}.
+		AND({{end}}a.{{$f.Name}}.EQ({{$f.Literal}}){{if gt $i 0}}){{end}}
+	{{- end}}
+}
+{{- end}}
+{{- with softDeleteColumn}}
+{{- $field := .}}
+
+// NotDeleted returns condition that matches {{tableTemplate.TypeName}} rows which are not soft deleted
+func (a {{tableTemplate.TypeName}}) NotDeleted() {{dialect.PackageName}}.BoolExpression {
+{{- if eq $field.Type "Bool"}}
+	return a.{{$field.Name}}.IS_NOT_TRUE()
+{{- else}}
+	return a.{{$field.Name}}.IS_NULL()
+{{- end}}
+}
+{{- if tableTemplate.SoftDeleteScope}}
+
+// SELECT creates new SELECT statement from {{tableTemplate.TypeName}}, from which soft deleted rows are excluded.
+// Use WithDeleted to select soft deleted rows as well.
+func (a {{tableTemplate.TypeName}}) SELECT(projection {{dialect.PackageName}}.Projection, projections ...{{dialect.PackageName}}.Projection) {{dialect.PackageName}}.SelectStatement {
+	return {{dialect.PackageName}}.ScopedSelect(a.Table, a.NotDeleted(), append([]{{dialect.PackageName}}.Projection{projection}, projections...)...)
+}
+
+// WithDeleted returns {{tableTemplate.TypeName}} table without soft delete scope
+func (a {{tableTemplate.TypeName}}) WithDeleted() {{dialect.PackageName}}.Table {
+	return a.Table
+}
+{{- end}}
+{{- end}}
 
 func new{{tableTemplate.TypeName}}(schemaName, tableName, alias string) {{tableTemplate.TypeName}} {
 	var (
@@ -57,7 +131,17 @@ func new{{tableTemplate.TypeName}}(schemaName, tableName, alias string) {{tableT
 {{- end}}
 		allColumns     = {{dialect.PackageName}}.ColumnList{ {{template "column-list" .Columns}} }
 		mutableColumns = {{dialect.PackageName}}.ColumnList{ {{template "column-list" .MutableColumns}} }
+		defaultColumns = {{dialect.PackageName}}.ColumnList{ {{template "column-list" defaultColumns}} }
 	)
+{{- range $i, $c := .Columns}}
+{{- $field := columnField $c}}
+{{- if $c.IsGenerated}}
+	{{dialect.PackageName}}.SetGenerated({{$field.Name}}Column)
+{{- end}}
+{{- if $c.HasDefault}}
+	{{dialect.PackageName}}.SetHasDefault({{$field.Name}}Column)
+{{- end}}
+{{- end}}
 
 	return {{tableTemplate.TypeName}}{
 		Table: {{dialect.PackageName}}.NewTable(schemaName, tableName, alias, allColumns...),
@@ -70,6 +154,7 @@ func new{{tableTemplate.TypeName}}(schemaName, tableName, alias string) {{tableT
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
 	}
 }
 `
@@ -86,6 +171,9 @@ package {{package}}
 
 import (
 	"github.com/go-jet/jet/v2/{{dialect.PackageName}}"
+{{- range primaryKeyImports}}
+	"{{.}}"
+{{- end}}
 )
 
 var {{tableTemplate.InstanceName}} = new{{tableTemplate.TypeName}}("{{schemaName}}", "{{.Name}}", "")
@@ -101,6 +189,7 @@ type {{structImplName}} struct {
 
 	AllColumns     {{dialect.PackageName}}.ColumnList
 	MutableColumns {{dialect.PackageName}}.ColumnList
+	DefaultColumns {{dialect.PackageName}}.ColumnList
 }
 
 type {{tableTemplate.TypeName}} struct {
@@ -109,6 +198,31 @@ type {{tableTemplate.TypeName}} struct {
 	EXCLUDED {{structImplName}}
 }
 
+{{- if not isView}}
+
+// INSERT_MODEL creates new INSERT statement for all mutable columns, with values taken from the model.
+// Model fields are matched with columns using the same rules as query result mapping.
+// Columns with database default value are omitted if corresponding model field is nil pointer.
+{{- if .PrimaryKeyColumnsWithDefault}}
+// Primary key values generated by database are returned with RETURNING clause, and can be scanned back into the model.
+func (a {{tableTemplate.TypeName}}) INSERT_MODEL(model interface{}) {{dialect.PackageName}}.InsertStatement {
+	return a.INSERT(a.MutableColumns.ExceptZeroDefaults(model)).MODEL(model).
+		RETURNING({{- range $i, $c := .PrimaryKeyColumnsWithDefault}}{{if gt $i 0}}, {{end}}a.{{(columnField $c).Name}}{{- end}})
+}
+{{- else}}
+func (a {{tableTemplate.TypeName}}) INSERT_MODEL(model interface{}) {{dialect.PackageName}}.InsertStatement {
+	return a.INSERT(a.MutableColumns.ExceptZeroDefaults(model)).MODEL(model)
+}
+{{- end}}
+{{- if .PrimaryKeyColumns}}
+
+// InsertOrIgnore creates new INSERT statement from the model, which does nothing if the row conflicts with existing one on primary key.
+func (a {{tableTemplate.TypeName}}) InsertOrIgnore(model interface{}) {{dialect.PackageName}}.InsertStatement {
+	return a.INSERT_MODEL(model).ON_CONFLICT(a.PrimaryKey()...).DO_NOTHING()
+}
+{{- end}}
+{{- end}}
+
 // AS creates new {{tableTemplate.TypeName}} with assigned alias
 func (a {{tableTemplate.TypeName}}) AS(alias string) *{{tableTemplate.TypeName}} {
 	return new{{tableTemplate.TypeName}}(a.SchemaName(), a.TableName(), alias)
@@ -118,6 +232,59 @@ func (a {{tableTemplate.TypeName}}) AS(alias string) *{{tableTemplate.TypeName}}
 func (a {{tableTemplate.TypeName}}) FromSchema(schemaName string) *{{tableTemplate.TypeName}} {
 	return new{{tableTemplate.TypeName}}(schemaName, a.TableName(), a.Alias())
 }
+{{- if .PrimaryKeyColumns}}
+
+// PrimaryKey returns list of {{tableTemplate.TypeName}} primary key columns
+func (a {{tableTemplate.TypeName}}) PrimaryKey() {{dialect.PackageName}}.ColumnList {
+	return {{dialect.PackageName}}.ColumnList{
+	{{- range $i, $c := .PrimaryKeyColumns}}
+	{{- $field := columnField $c}}
+		{{- if gt $i 0 }}, {{end}}a.{{$field.Name}}
+	{{- end}}}
+}
+{{- end}}
+{{- with primaryKeyFields}}
+
+// {{tableTemplate.InstanceName}}PK is {{tableTemplate.TypeName}} composite primary key value
+type {{tableTemplate.InstanceName}}PK struct {
+{{- range .}}
+	{{.Name}} {{.Type.Name}}
+{{- end}}
+}
+
+// ByKey returns condition that matches {{tableTemplate.TypeName}} row by composite primary key value
+func (a {{tableTemplate.TypeName}}) ByKey(key {{tableTemplate.InstanceName}}PK) {{dialect.PackageName}}.BoolExpression {
+	return {{range $i, $f := .}}
+	{{- if gt $i 0}}.
+		AND({{end}}a.{{$f.Name}}.EQ({{$f.Literal}}){{if gt $i 0}}){{end}}
+	{{- end}}
+}
+{{- end}}
+{{- with softDeleteColumn}}
+{{- $field := .}}
+
+// NotDeleted returns condition that matches {{tableTemplate.TypeName}} rows which are not soft deleted
+func (a {{tableTemplate.TypeName}}) NotDeleted() {{dialect.PackageName}}.BoolExpression {
+{{- if eq $field.Type "Bool"}}
+	return a.{{$field.Name}}.IS_NOT_TRUE()
+{{- else}}
+	return a.{{$field.Name}}.IS_NULL()
+{{- end}}
+}
+{{- if tableTemplate.SoftDeleteScope}}
+
+// SELECT creates new SELECT statement from {{tableTemplate.TypeName}}, from which soft deleted rows are excluded.
+// Use WithDeleted to select soft deleted rows as well.
+func (a {{tableTemplate.TypeName}}) SELECT(projection {{dialect.PackageName}}.Projection, projections ...{{dialect.PackageName}}.Projection) {{dialect.PackageName}}.SelectStatement {
+	return {{dialect.PackageName}}.ScopedSelect(a.Table, a.NotDeleted(), append([]{{dialect.PackageName}}.Projection{projection}, projections...)...)
+}
+
+// WithDeleted returns {{tableTemplate.TypeName}} table without soft delete scope
+func (a {{tableTemplate.TypeName}}) WithDeleted() {{dialect.PackageName}}.Table {
+	return a.Table
+}
+{{- end}}
+{{- end}}
 
 func new{{tableTemplate.TypeName}}(schemaName, tableName, alias string) *{{tableTemplate.TypeName}} {
 	return &{{tableTemplate.TypeName}}{
@@ -134,7 +301,17 @@ func new{{tableTemplate.TypeName}}Impl(schemaName, tableName, alias string) {{st
 {{- end}}
 		allColumns     = {{dialect.PackageName}}.ColumnList{ {{template "column-list" .Columns}} }
 		mutableColumns = {{dialect.PackageName}}.ColumnList{ {{template "column-list" .MutableColumns}} }
+		defaultColumns = {{dialect.PackageName}}.ColumnList{ {{template "column-list" defaultColumns}} }
 	)
+{{- range $i, $c := .Columns}}
+{{- $field := columnField $c}}
+{{- if $c.IsGenerated}}
+	{{dialect.PackageName}}.SetGenerated({{$field.Name}}Column)
+{{- end}}
+{{- if $c.HasDefault}}
+	{{dialect.PackageName}}.SetHasDefault({{$field.Name}}Column)
+{{- end}}
+{{- end}}
 
 	return {{structImplName}}{
 		Table: {{dialect.PackageName}}.NewTable(schemaName, tableName, alias, allColumns...),
@@ -147,6 +324,7 @@ func new{{tableTemplate.TypeName}}Impl(schemaName, tableName, alias string) {{st
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
 	}
 }
 `
@@ -163,12 +341,21 @@ import (
 
 {{$modelTableTemplate := tableTemplate}}
 type {{$modelTableTemplate.TypeName}} struct {
-{{- range .Columns}}
-{{- $field := structField .}}
+{{- range structFields}}
+{{- $field := .}}
 	{{$field.Name}} {{$field.Type.Name}} ` + "{{$field.TagsString}}" + `
 {{- end}}
 }
 
+{{- with seedConstants}}
+
+const (
+{{- range .}}
+	{{.Name}} {{.Type}} = {{.Value}}
+{{- end}}
+)
+{{- end}}
+
 `
 
 var enumSQLBuilderTemplate = `package {{package}}
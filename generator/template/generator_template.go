@@ -9,6 +9,8 @@ import (
 type Template struct {
 	Dialect jet.Dialect
 	Schema  func(schemaMetaData metadata.Schema) Schema
+	// SeedTables are reference tables whose rows are read from database, to generate model constants
+	SeedTables []metadata.SeedTable
 }
 
 // Default is default generator template implementation
@@ -25,6 +27,13 @@ func (t Template) UseSchema(schemaFunc func(schemaMetaData metadata.Schema) Sche
 	return t
 }
 
+// UseSeedTables returns new generator template, which generates model constants from rows of seedTables.
+// Constants are named after natural key column values, and typed and valued as table primary key.
+func (t Template) UseSeedTables(seedTables ...metadata.SeedTable) Template {
+	t.SeedTables = seedTables
+	return t
+}
+
 // Schema is schema generator template used to generate schema(model and sql builder) files
 type Schema struct {
 	Path       string
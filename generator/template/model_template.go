@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/internal/utils"
+	"github.com/go-jet/jet/v2/postgres"
 	"github.com/google/uuid"
 	"path"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
 )
 
 // Model is template for model files generation
@@ -18,6 +22,8 @@ type Model struct {
 	Table func(table metadata.Table) TableModel
 	View  func(table metadata.Table) ViewModel
 	Enum  func(enum metadata.Enum) EnumModel
+	// TypeMapping, if set, overrides default model field type for column data types it returns true for.
+	TypeMapping func(dataType metadata.DataType) (Type, bool)
 }
 
 // PackageName returns package name of model types
@@ -49,6 +55,34 @@ func (m Model) UseEnum(enumFunc func(enumMetaData metadata.Enum) EnumModel) Mode
 	return m
 }
 
+// UseTypeMapping returns new Model template with model field types overridden by typeMapping function. Data types for
+// which typeMapping returns false use default type mapping. Column types set with '@type:' comment directive are not
+// overridden. Pointer to mapped type is used for nullable columns.
+func (m Model) UseTypeMapping(typeMapping func(dataType metadata.DataType) (Type, bool)) Model {
+	m.TypeMapping = typeMapping
+	return m
+}
+
+// TypeMap returns type mapping function which maps sql data type names to model field types, for instance:
+//
+//	TypeMap(map[string]Type{
+//		"timestamptz": {ImportPath: "github.com/acme/types", Name: "types.Time"},
+//		"money":       {ImportPath: "github.com/acme/types", Name: "types.Money"},
+//	})
+//
+// Data type names are matched case-insensitively.
+func TypeMap(types map[string]Type) func(dataType metadata.DataType) (Type, bool) {
+	lowerTypes := map[string]Type{}
+	for typeName, modelType := range types {
+		lowerTypes[strings.ToLower(typeName)] = modelType
+	}
+
+	return func(dataType metadata.DataType) (Type, bool) {
+		modelType, ok := lowerTypes[strings.ToLower(dataType.Name)]
+		return modelType, ok
+	}
+}
+
 // DefaultModel returns default Model template implementation
 func DefaultModel() Model {
 	return Model{
@@ -66,6 +100,8 @@ type TableModel struct {
 	FileName string
 	TypeName string
 	Field    func(columnMetaData metadata.Column) TableModelField
+	// FieldOrder, if set, reorders generated struct fields. By default, fields follow table column order.
+	FieldOrder func(fields []TableModelField) []TableModelField
 }
 
 // ViewModel is template for view model files generation
@@ -101,6 +137,85 @@ func (t TableModel) UseField(structFieldFunc func(columnMetaData metadata.Column
 	return t
 }
 
+// UseFieldOrder returns new TableModel with struct fields ordered by fieldOrderFunc, instead of table column order.
+// Column to field mapping does not depend on field order, because fields are matched with columns by name.
+func (t TableModel) UseFieldOrder(fieldOrderFunc func(fields []TableModelField) []TableModelField) TableModel {
+	t.FieldOrder = fieldOrderFunc
+	return t
+}
+
+// AlphabeticalFieldOrder orders struct fields alphabetically by field name
+func AlphabeticalFieldOrder(fields []TableModelField) []TableModelField {
+	ret := append([]TableModelField{}, fields...)
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+
+	return ret
+}
+
+// FieldOrderOf returns field order function which places fields with listed names first, in the listed order,
+// followed by the remaining fields in table column order.
+func FieldOrderOf(fieldNames ...string) func(fields []TableModelField) []TableModelField {
+	return func(fields []TableModelField) []TableModelField {
+		position := map[string]int{}
+		for i, fieldName := range fieldNames {
+			position[fieldName] = i
+		}
+
+		ret := append([]TableModelField{}, fields...)
+
+		sort.SliceStable(ret, func(i, j int) bool {
+			pi, iListed := position[ret[i].Name]
+			pj, jListed := position[ret[j].Name]
+
+			if iListed && jListed {
+				return pi < pj
+			}
+
+			return iListed && !jListed
+		})
+
+		return ret
+	}
+}
+
+// withTypeMapping returns table model field template function with field types overridden by typeMapping
+func withTypeMapping(field func(columnMetaData metadata.Column) TableModelField,
+	typeMapping func(dataType metadata.DataType) (Type, bool)) func(columnMetaData metadata.Column) TableModelField {
+
+	return func(columnMetaData metadata.Column) TableModelField {
+		ret := field(columnMetaData)
+
+		if strings.Contains(columnMetaData.Comment, commentTypeDirective) {
+			return ret
+		}
+
+		if mappedType, ok := typeMapping(columnMetaData.DataType); ok {
+			if columnMetaData.IsNullable {
+				mappedType.Name = "*" + mappedType.Name
+			}
+			ret.Type = mappedType
+		}
+
+		return ret
+	}
+}
+
+func getTableModelFields(modelType TableModel, tableMetaData metadata.Table) []TableModelField {
+	var fields []TableModelField
+	for _, columnMetaData := range tableMetaData.Columns {
+		fields = append(fields, modelType.Field(columnMetaData))
+	}
+
+	if modelType.FieldOrder != nil {
+		fields = modelType.FieldOrder(fields)
+	}
+
+	return fields
+}
+
 func getTableModelImports(modelType TableModel, tableMetaData metadata.Table) []string {
 	importPaths := map[string]bool{}
 	for _, columnMetaData := range tableMetaData.Columns {
@@ -120,6 +235,72 @@ func getTableModelImports(modelType TableModel, tableMetaData metadata.Table) []
 	return ret
 }
 
+// seedConstant is model constant generated from reference table row
+type seedConstant struct {
+	Name  string
+	Type  string
+	Value string
+}
+
+// getTableSeedConstants returns constants generated from table seed rows. Constants are named after natural key
+// values, prefixed with model type name, and typed and valued as table primary key.
+func getTableSeedConstants(modelType TableModel, tableMetaData metadata.Table) []seedConstant {
+	if len(tableMetaData.SeedRows) == 0 {
+		return nil
+	}
+
+	primaryKeys := tableMetaData.PrimaryKeyColumns()
+
+	if len(primaryKeys) != 1 {
+		panic(fmt.Sprintf("seed constants table '%s' should have single column primary key", tableMetaData.Name))
+	}
+
+	constantType := strings.TrimPrefix(modelType.Field(primaryKeys[0]).Type.Name, "*")
+	names := map[string]bool{}
+
+	var ret []seedConstant
+
+	for _, row := range tableMetaData.SeedRows {
+		name := modelType.TypeName + utils.ToGoIdentifier(row.Key)
+
+		if names[name] {
+			panic(fmt.Sprintf("seed constants table '%s' has duplicated constant name '%s'", tableMetaData.Name, name))
+		}
+		names[name] = true
+
+		ret = append(ret, seedConstant{
+			Name:  name,
+			Type:  constantType,
+			Value: seedConstantValue(tableMetaData.Name, constantType, row.Value),
+		})
+	}
+
+	return ret
+}
+
+func seedConstantValue(tableName, constantType, value string) string {
+	var err error
+
+	switch constantType {
+	case "string":
+		return strconv.Quote(value)
+	case "int8", "int16", "int32", "int64", "int":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case "uint8", "uint16", "uint32", "uint64", "uint":
+		_, err = strconv.ParseUint(value, 10, 64)
+	case "float32", "float64":
+		_, err = strconv.ParseFloat(value, 64)
+	default:
+		panic(fmt.Sprintf("seed constants of table '%s' can not be of type %s", tableName, constantType))
+	}
+
+	if err != nil {
+		panic(fmt.Sprintf("seed constants table '%s' has invalid %s value '%s'", tableName, constantType, value))
+	}
+
+	return value
+}
+
 // EnumModel is template for enum model files generation
 type EnumModel struct {
 	Skip      bool
@@ -232,6 +413,20 @@ func getImportPath(dummyData interface{}) string {
 }
 
 func getType(columnMetadata metadata.Column) Type {
+	if commentType, ok := getCommentType(columnMetadata); ok {
+		if columnMetadata.IsNullable {
+			commentType.Name = "*" + commentType.Name
+		}
+		return commentType
+	}
+
+	if isHStoreType(columnMetadata.DataType) {
+		if columnMetadata.IsNullable {
+			return Type{ImportPath: hstoreType.ImportPath, Name: "*" + hstoreType.Name}
+		}
+		return hstoreType
+	}
+
 	userDefinedType := getUserDefinedType(columnMetadata)
 
 	if userDefinedType != "" {
@@ -244,6 +439,95 @@ func getType(columnMetadata metadata.Column) Type {
 	return NewType(getGoType(columnMetadata))
 }
 
+// hstoreType is model type of PostgreSQL hstore extension type columns
+var hstoreType = Type{ImportPath: "github.com/go-jet/jet/v2/postgres", Name: "postgres.HStoreMap"}
+
+func isHStoreType(dataType metadata.DataType) bool {
+	return dataType.Kind == metadata.UserDefinedType && strings.ToLower(dataType.Name) == "hstore"
+}
+
+const commentTypeDirective = "@type:"
+
+// getCommentType returns model type set with '@type:' directive in column comment. Type has to be qualified with
+// import path, for instance '@type:github.com/shopspring/decimal.Decimal' or '@type:time.Duration', because generated
+// model package is deleted and recreated on each generation. If directive type is not valid, default type mapping is used.
+func getCommentType(column metadata.Column) (Type, bool) {
+	directiveIndex := strings.Index(column.Comment, commentTypeDirective)
+
+	if directiveIndex < 0 {
+		return Type{}, false
+	}
+
+	directive := strings.Fields(column.Comment[directiveIndex+len(commentTypeDirective):])
+
+	if len(directive) == 0 {
+		fmt.Println("- [Model      ] Empty @type directive for column '" + column.Name + "', using default type instead.")
+		return Type{}, false
+	}
+
+	typeName := directive[0]
+	dotIndex := strings.LastIndex(typeName, ".")
+
+	if dotIndex < 0 {
+		fmt.Println("- [Model      ] Type '" + typeName + "' from @type directive for column '" + column.Name + "' is not qualified with import path, using default type instead.")
+		return Type{}, false
+	}
+
+	importPath, name := typeName[:dotIndex], typeName[dotIndex+1:]
+	packageName := importPackageName(importPath)
+
+	if !isGoIdentifier(packageName) || !isGoIdentifier(name) {
+		fmt.Println("- [Model      ] Type '" + typeName + "' from @type directive for column '" + column.Name + "' is not importable, using default type instead.")
+		return Type{}, false
+	}
+
+	return Type{
+		ImportPath: importPath,
+		Name:       packageName + "." + name,
+	}, true
+}
+
+// importPackageName returns package name of importPath, assuming package name matches the last path element without
+// major version, for instance: github.com/acme/types/v2 -> types, gopkg.in/yaml.v3 -> yaml
+func importPackageName(importPath string) string {
+	packageName := path.Base(importPath)
+
+	if isMajorVersion(packageName) && path.Dir(importPath) != "." {
+		packageName = path.Base(path.Dir(importPath))
+	}
+
+	if versionIndex := strings.LastIndex(packageName, ".v"); versionIndex > 0 && isMajorVersion(packageName[versionIndex+1:]) {
+		packageName = packageName[:versionIndex]
+	}
+
+	return packageName
+}
+
+// isMajorVersion returns true for module major version suffix, for instance v2
+func isMajorVersion(element string) bool {
+	if len(element) < 2 || element[0] != 'v' {
+		return false
+	}
+
+	_, err := strconv.Atoi(element[1:])
+
+	return err == nil
+}
+
+func isGoIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for i, r := range name {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func getUserDefinedType(column metadata.Column) string {
 	switch column.DataType.Kind {
 	case metadata.EnumType:
@@ -309,7 +593,7 @@ func toGoType(column metadata.Column) interface{} {
 		"character varying", "varchar", "nvarchar",
 		"tsvector", "bit", "bit varying", "varbit",
 		"money", "json", "jsonb",
-		"xml", "point", "interval", "line", "array",
+		"xml", "point", "line", "array",
 		"char", "tinytext", "mediumtext", "longtext": // MySQL
 		return ""
 	case "real", "float4":
@@ -320,6 +604,8 @@ func toGoType(column metadata.Column) interface{} {
 		return float64(0.0)
 	case "uuid":
 		return uuid.UUID{}
+	case "interval":
+		return postgres.Interval{}
 	default:
 		fmt.Println("- [Model      ] Unsupported sql column '" + column.Name + " " + column.DataType.Name + "', using string instead.")
 		return ""
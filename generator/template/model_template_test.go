@@ -3,6 +3,9 @@ package template
 import (
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"path"
 	"testing"
 )
 
@@ -42,4 +45,156 @@ func Test_TableModelField(t *testing.T) {
 		},
 		Tags: nil,
 	})
+
+	require.Equal(t, DefaultTableModelField(metadata.Column{
+		Name:       "attributes",
+		IsNullable: true,
+		DataType: metadata.DataType{
+			Name: "hstore",
+			Kind: metadata.UserDefinedType,
+		},
+	}), TableModelField{
+		Name: "Attributes",
+		Type: Type{
+			ImportPath: "github.com/go-jet/jet/v2/postgres",
+			Name:       "*postgres.HStoreMap",
+		},
+		Tags: nil,
+	})
+}
+
+func TestTableModelFieldOrder(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet-generator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	tables := []metadata.Table{
+		{
+			Name: "account",
+			Columns: []metadata.Column{
+				{Name: "id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+				{Name: "name", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+				{Name: "created_at", DataType: metadata.DataType{Name: "timestamp", Kind: metadata.BaseType}},
+				{Name: "email", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+			},
+		},
+	}
+
+	generate := func(tableModel func(table metadata.Table) TableModel) string {
+		processTableModels("table", dirPath, tables, DefaultModel().UseTable(tableModel))
+
+		account, err := ioutil.ReadFile(path.Join(dirPath, "account.go"))
+		require.NoError(t, err)
+		return string(account)
+	}
+
+	require.Contains(t, generate(DefaultTableModel), `
+type Account struct {
+	ID        int32 `+"`sql:\"primary_key\"`"+`
+	Name      string
+	CreatedAt time.Time
+	Email     string
+}
+`)
+
+	require.Contains(t, generate(func(table metadata.Table) TableModel {
+		return DefaultTableModel(table).UseFieldOrder(AlphabeticalFieldOrder)
+	}), `
+type Account struct {
+	CreatedAt time.Time
+	Email     string
+	ID        int32 `+"`sql:\"primary_key\"`"+`
+	Name      string
+}
+`)
+
+	require.Contains(t, generate(func(table metadata.Table) TableModel {
+		return DefaultTableModel(table).UseFieldOrder(FieldOrderOf("Email", "ID"))
+	}), `
+type Account struct {
+	Email     string
+	ID        int32 `+"`sql:\"primary_key\"`"+`
+	Name      string
+	CreatedAt time.Time
+}
+`)
+}
+
+func TestTableModelFieldCommentType(t *testing.T) {
+	column := metadata.Column{
+		Name:     "status",
+		DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType},
+	}
+
+	withComment := func(comment string, nullable bool) metadata.Column {
+		ret := column
+		ret.Comment = comment
+		ret.IsNullable = nullable
+		return ret
+	}
+
+	require.Equal(t, Type{
+		ImportPath: "github.com/acme/types",
+		Name:       "*types.OrderStatus",
+	}, DefaultTableModelField(withComment("order status @type:github.com/acme/types.OrderStatus", true)).Type)
+	require.Equal(t, Type{
+		ImportPath: "github.com/shopspring/decimal",
+		Name:       "decimal.Decimal",
+	}, DefaultTableModelField(withComment("@type:github.com/shopspring/decimal.Decimal", false)).Type)
+	require.Equal(t, Type{ImportPath: "time", Name: "*time.Duration"}, DefaultTableModelField(withComment("@type:time.Duration", true)).Type)
+	require.Equal(t, Type{
+		ImportPath: "github.com/acme/types/v2",
+		Name:       "types.Money",
+	}, DefaultTableModelField(withComment("@type:github.com/acme/types/v2.Money", false)).Type)
+	require.Equal(t, Type{ImportPath: "gopkg.in/yaml.v3", Name: "yaml.Node"}, DefaultTableModelField(withComment("@type:gopkg.in/yaml.v3.Node", false)).Type)
+
+	// unqualified, not importable or invalid types fallback to default type mapping
+	require.Equal(t, Type{Name: "string"}, DefaultTableModelField(withComment("@type:OrderStatus", false)).Type)
+	require.Equal(t, Type{Name: "string"}, DefaultTableModelField(withComment("@type:github.com/acme/go-types.Money", false)).Type)
+	require.Equal(t, Type{Name: "string"}, DefaultTableModelField(withComment("@type:Order-Status", false)).Type)
+	require.Equal(t, Type{Name: "*string"}, DefaultTableModelField(withComment("@type:", true)).Type)
+	require.Equal(t, Type{Name: "string"}, DefaultTableModelField(withComment("order status", false)).Type)
+}
+
+func TestModelTypeMapping(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet-generator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	tables := []metadata.Table{
+		{
+			Name: "invoice",
+			Columns: []metadata.Column{
+				{Name: "id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+				{Name: "amount", DataType: metadata.DataType{Name: "money", Kind: metadata.BaseType}},
+				{Name: "created_at", DataType: metadata.DataType{Name: "timestamp with time zone", Kind: metadata.BaseType}},
+				{Name: "paid_at", IsNullable: true, DataType: metadata.DataType{Name: "timestamp with time zone", Kind: metadata.BaseType}},
+				{Name: "refund", IsNullable: true, Comment: "@type:github.com/acme/types.Refund", DataType: metadata.DataType{Name: "money", Kind: metadata.BaseType}},
+			},
+		},
+	}
+
+	modelTemplate := DefaultModel().UseTypeMapping(TypeMap(map[string]Type{
+		"MONEY":                    {ImportPath: "github.com/acme/types", Name: "types.Money"},
+		"timestamp with time zone": {ImportPath: "github.com/acme/types", Name: "types.Time"},
+	}))
+
+	processTableModels("table", dirPath, tables, modelTemplate)
+
+	invoice, err := ioutil.ReadFile(path.Join(dirPath, "invoice.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(invoice), `
+import (
+	"github.com/acme/types"
+)
+`)
+	require.Contains(t, string(invoice), `
+type Invoice struct {
+	ID        int32 `+"`sql:\"primary_key\"`"+`
+	Amount    types.Money
+	CreatedAt types.Time
+	PaidAt    *types.Time
+	Refund    *types.Refund
+}
+`)
 }
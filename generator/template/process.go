@@ -137,30 +137,7 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 		err := utils.EnsureDirPath(tableSQLBuilderPath)
 		throw.OnError(err)
 
-		text, err := generateTemplate(
-			autoGenWarningTemplate+getTableSQLBuilderTemplate(dialect),
-			tableMetaData,
-			template.FuncMap{
-				"package": func() string {
-					return tableSQLBuilderTemplate.PackageName()
-				},
-				"dialect": func() jet.Dialect {
-					return dialect
-				},
-				"schemaName": func() string {
-					return schemaMetaData.Name
-				},
-				"tableTemplate": func() TableSQLBuilder {
-					return tableSQLBuilderTemplate
-				},
-				"structImplName": func() string { // postgres only
-					structName := tableSQLBuilderTemplate.TypeName
-					return string(strings.ToLower(structName)[0]) + structName[1:]
-				},
-				"columnField": func(columnMetaData metadata.Column) TableSQLBuilderColumn {
-					return tableSQLBuilderTemplate.Column(columnMetaData)
-				},
-			})
+		text, err := generateTableSQLBuilder(dialect, schemaMetaData.Name, tableMetaData, tableSQLBuilderTemplate, fileTypes == "view")
 		throw.OnError(err)
 
 		err = utils.SaveGoFile(tableSQLBuilderPath, tableSQLBuilderTemplate.FileName, text)
@@ -168,6 +145,50 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 	}
 }
 
+// generateTableSQLBuilder generates table or view sql builder file text. INSERT helpers are not generated for views.
+func generateTableSQLBuilder(dialect jet.Dialect, schemaName string, tableMetaData metadata.Table,
+	tableSQLBuilderTemplate TableSQLBuilder, isView bool) ([]byte, error) {
+	return generateTemplate(
+		autoGenWarningTemplate+getTableSQLBuilderTemplate(dialect),
+		tableMetaData,
+		template.FuncMap{
+			"package": func() string {
+				return tableSQLBuilderTemplate.PackageName()
+			},
+			"dialect": func() jet.Dialect {
+				return dialect
+			},
+			"schemaName": func() string {
+				return schemaName
+			},
+			"tableTemplate": func() TableSQLBuilder {
+				return tableSQLBuilderTemplate
+			},
+			"isView": func() bool {
+				return isView
+			},
+			"structImplName": func() string { // postgres only
+				structName := tableSQLBuilderTemplate.TypeName
+				return string(strings.ToLower(structName)[0]) + structName[1:]
+			},
+			"columnField": func(columnMetaData metadata.Column) TableSQLBuilderColumn {
+				return tableSQLBuilderTemplate.Column(columnMetaData)
+			},
+			"softDeleteColumn": func() *TableSQLBuilderColumn {
+				return tableSQLBuilderTemplate.softDeleteColumn(tableMetaData)
+			},
+			"defaultColumns": func() []metadata.Column {
+				return tableSQLBuilderTemplate.defaultColumns(tableMetaData)
+			},
+			"primaryKeyFields": func() []primaryKeyField {
+				return tableSQLBuilderTemplate.primaryKeyFields(dialect, tableMetaData)
+			},
+			"primaryKeyImports": func() []string {
+				return tableSQLBuilderTemplate.primaryKeyImports(dialect, tableMetaData)
+			},
+		})
+}
+
 func getTableSQLBuilderTemplate(dialect jet.Dialect) string {
 	if dialect.Name() == "PostgreSQL" || dialect.Name() == "SQLite" {
 		return tableSQLBuilderTemplateWithEXCLUDED
@@ -195,6 +216,10 @@ func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadat
 			continue
 		}
 
+		if modelTemplate.TypeMapping != nil {
+			tableTemplate.Field = withTypeMapping(tableTemplate.Field, modelTemplate.TypeMapping)
+		}
+
 		text, err := generateTemplate(
 			autoGenWarningTemplate+tableModelFileTemplate,
 			tableMetaData,
@@ -208,8 +233,11 @@ func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadat
 				"tableTemplate": func() TableModel {
 					return tableTemplate
 				},
-				"structField": func(columnMetaData metadata.Column) TableModelField {
-					return tableTemplate.Field(columnMetaData)
+				"structFields": func() []TableModelField {
+					return getTableModelFields(tableTemplate, tableMetaData)
+				},
+				"seedConstants": func() []seedConstant {
+					return getTableSeedConstants(tableTemplate, tableMetaData)
 				},
 			})
 		throw.OnError(err)
@@ -3,6 +3,7 @@ package template
 import (
 	"fmt"
 	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/internal/jet"
 	"github.com/go-jet/jet/v2/internal/utils"
 	"path"
 	"strings"
@@ -54,26 +55,53 @@ func (sb SQLBuilder) UseEnum(enumFunc func(enum metadata.Enum) EnumSQLBuilder) S
 
 // TableSQLBuilder is template for generating table SQLBuilder files
 type TableSQLBuilder struct {
-	Skip         bool
-	Path         string
-	FileName     string
-	InstanceName string
-	TypeName     string
-	Column       func(columnMetaData metadata.Column) TableSQLBuilderColumn
+	Skip             bool
+	Path             string
+	FileName         string
+	InstanceName     string
+	TypeName         string
+	SoftDeleteColumn string
+	SoftDeleteScope  bool
+	LargeColumnTypes []string
+	Column           func(columnMetaData metadata.Column) TableSQLBuilderColumn
 }
 
+// SoftDeleteColumnNames is list of conventional soft delete column names. If table contains column with one of
+// these names, default TableSQLBuilder generates NotDeleted table method.
+var SoftDeleteColumnNames = []string{"deleted_at", "deleted"}
+
+// LargeColumnTypes is list of data types whose values can be large, and are usually stored out of table row (for
+// instance TOASTed in PostgreSQL). Columns of these data types are excluded from generated DefaultColumns list,
+// so list queries selecting DefaultColumns do not fetch large values accidentally. Plain text is not listed, because
+// it is commonly used for short unbounded strings as well.
+var LargeColumnTypes = []string{"mediumtext", "longtext", "json", "jsonb", "xml", "bytea", "blob", "mediumblob", "longblob"}
+
 // ViewSQLBuilder is template for generating view SQLBuilder files
 type ViewSQLBuilder = TableSQLBuilder
 
 // DefaultTableSQLBuilder returns default implementation for TableSQLBuilder
 func DefaultTableSQLBuilder(tableMetaData metadata.Table) TableSQLBuilder {
 	return TableSQLBuilder{
-		Path:         "/table",
-		FileName:     utils.ToGoFileName(tableMetaData.Name),
-		InstanceName: utils.ToGoIdentifier(tableMetaData.Name),
-		TypeName:     utils.ToGoIdentifier(tableMetaData.Name) + "Table",
-		Column:       DefaultTableSQLBuilderColumn,
+		Path:             "/table",
+		FileName:         utils.ToGoFileName(tableMetaData.Name),
+		InstanceName:     utils.ToGoIdentifier(tableMetaData.Name),
+		TypeName:         utils.ToGoIdentifier(tableMetaData.Name) + "Table",
+		SoftDeleteColumn: defaultSoftDeleteColumn(tableMetaData),
+		LargeColumnTypes: LargeColumnTypes,
+		Column:           DefaultTableSQLBuilderColumn,
+	}
+}
+
+func defaultSoftDeleteColumn(tableMetaData metadata.Table) string {
+	for _, softDeleteColumnName := range SoftDeleteColumnNames {
+		for _, column := range tableMetaData.Columns {
+			if column.Name == softDeleteColumnName {
+				return column.Name
+			}
+		}
 	}
+
+	return ""
 }
 
 // DefaultViewSQLBuilder returns default implementation for ViewSQLBuilder
@@ -112,6 +140,154 @@ func (tb TableSQLBuilder) UseTypeName(name string) TableSQLBuilder {
 	return tb
 }
 
+// UseSoftDeleteColumn returns new TableSQLBuilder with new soft delete column name set. NotDeleted table method
+// is generated only if table contains soft delete column. Empty name disables NotDeleted method generation.
+func (tb TableSQLBuilder) UseSoftDeleteColumn(columnName string) TableSQLBuilder {
+	tb.SoftDeleteColumn = columnName
+	return tb
+}
+
+// UseSoftDeleteScope returns new TableSQLBuilder with soft delete scope enabled or disabled. If enabled, generated
+// table SELECT method excludes soft deleted rows, and WithDeleted table method can be used to include them again.
+// Soft delete scope is generated only if table contains soft delete column.
+func (tb TableSQLBuilder) UseSoftDeleteScope(enabled bool) TableSQLBuilder {
+	tb.SoftDeleteScope = enabled
+	return tb
+}
+
+// UseLargeColumnTypes returns new TableSQLBuilder with new list of large column data types set. Columns of large
+// data types are excluded from generated DefaultColumns list. Data types are matched case-insensitively.
+func (tb TableSQLBuilder) UseLargeColumnTypes(dataTypes ...string) TableSQLBuilder {
+	tb.LargeColumnTypes = dataTypes
+	return tb
+}
+
+// defaultColumns returns list of table columns without the columns of large data types
+func (tb TableSQLBuilder) defaultColumns(tableMetaData metadata.Table) []metadata.Column {
+	var ret []metadata.Column
+
+	for _, column := range tableMetaData.Columns {
+		if tb.isLargeColumnType(column.DataType.Name) {
+			continue
+		}
+
+		ret = append(ret, column)
+	}
+
+	return ret
+}
+
+func (tb TableSQLBuilder) isLargeColumnType(dataType string) bool {
+	for _, largeColumnType := range tb.LargeColumnTypes {
+		if strings.EqualFold(largeColumnType, dataType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// softDeleteColumn returns sql builder column of the table soft delete column, or nil if table has no such column.
+// Soft delete column should be either bool column (deleted) or nullable column (deleted_at).
+func (tb TableSQLBuilder) softDeleteColumn(tableMetaData metadata.Table) *TableSQLBuilderColumn {
+	if tb.SoftDeleteColumn == "" {
+		return nil
+	}
+
+	for _, column := range tableMetaData.Columns {
+		if column.Name == tb.SoftDeleteColumn {
+			sqlBuilderColumn := tb.Column(column)
+			return &sqlBuilderColumn
+		}
+	}
+
+	return nil
+}
+
+// primaryKeyField is a field of generated composite primary key struct, holding Go value of the primary key column
+type primaryKeyField struct {
+	Name string
+	Type Type
+	// Literal is Go expression, which converts key struct field value into SQL literal of the column type
+	Literal string
+}
+
+// primaryKeyFields returns composite primary key struct fields, or nil if table does not have composite primary key
+func (tb TableSQLBuilder) primaryKeyFields(dialect jet.Dialect, tableMetaData metadata.Table) []primaryKeyField {
+	primaryKeyColumns := tableMetaData.PrimaryKeyColumns()
+
+	if len(primaryKeyColumns) < 2 {
+		return nil
+	}
+
+	var ret []primaryKeyField
+
+	for _, column := range primaryKeyColumns {
+		sqlBuilderColumn := tb.Column(column)
+		field := primaryKeyField{
+			Name: sqlBuilderColumn.Name,
+			Type: NewType(toGoType(column)),
+		}
+		field.Literal = primaryKeyLiteral(dialect.PackageName(), sqlBuilderColumn.Type, &field.Type, "key."+field.Name)
+
+		ret = append(ret, field)
+	}
+
+	return ret
+}
+
+// primaryKeyImports returns import paths of composite primary key struct field types
+func (tb TableSQLBuilder) primaryKeyImports(dialect jet.Dialect, tableMetaData metadata.Table) []string {
+	var ret []string
+
+	for _, field := range tb.primaryKeyFields(dialect, tableMetaData) {
+		if field.Type.ImportPath != "" && !utils.StringSliceContains(ret, field.Type.ImportPath) {
+			ret = append(ret, field.Type.ImportPath)
+		}
+	}
+
+	return ret
+}
+
+// primaryKeyLiteral returns Go expression, which creates SQL literal of the sql builder column type from Go value.
+// Key value type is changed to string, for the column types without a literal constructor from Go value.
+func primaryKeyLiteral(packageName, columnType string, valueType *Type, value string) string {
+	literal := func(constructor, value string) string {
+		return packageName + "." + constructor + "(" + value + ")"
+	}
+
+	switch columnType {
+	case "Bool":
+		return literal("Bool", value)
+	case "Integer":
+		return literal("Int", "int64("+value+")")
+	case "Float":
+		return literal("Float", "float64("+value+")")
+	case "String":
+		switch valueType.Name {
+		case "uuid.UUID":
+			return literal("UUID", value)
+		case "[]byte":
+			return literal("String", "string("+value+")")
+		}
+		return literal("String", value)
+	case "Interval":
+		if valueType.Name == "postgres.Interval" {
+			return literal("IntervalT", value)
+		}
+	case "Date", "Time", "Timestamp", "Timez", "Timestampz":
+		if packageName == "sqlite" {
+			sqliteFunc := map[string]string{"Date": "DATE", "Time": "TIME", "Timestamp": "DATETIME"}[columnType]
+			return literal(sqliteFunc, value)
+		}
+		return literal(columnType+"T", value)
+	}
+
+	*valueType = Type{Name: "string"}
+
+	return literal(columnType+"Exp", literal("String", value))
+}
+
 // UseColumn returns new TableSQLBuilder with new column template function set
 func (tb TableSQLBuilder) UseColumn(columnsFunc func(column metadata.Column) TableSQLBuilderColumn) TableSQLBuilder {
 	tb.Column = columnsFunc
@@ -134,6 +310,10 @@ func DefaultTableSQLBuilderColumn(columnMetaData metadata.Column) TableSQLBuilde
 
 // getSqlBuilderColumnType returns type of jet sql builder column
 func getSqlBuilderColumnType(columnMetaData metadata.Column) string {
+	if isHStoreType(columnMetaData.DataType) {
+		return "HStore" // PostgreSQL hstore extension type
+	}
+
 	if columnMetaData.DataType.Kind != metadata.BaseType {
 		return "String"
 	}
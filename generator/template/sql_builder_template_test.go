@@ -1,7 +1,15 @@
 package template
 
 import (
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/postgres"
 	"github.com/stretchr/testify/require"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path"
 	"testing"
 )
 
@@ -9,3 +17,283 @@ func TestToGoEnumValueIdentifier(t *testing.T) {
 	require.Equal(t, defaultEnumValueName("enum_name", "enum_value"), "EnumValue")
 	require.Equal(t, defaultEnumValueName("NumEnum", "100"), "NumEnum100")
 }
+
+func TestTableSQLBuilderSoftDeleteColumn(t *testing.T) {
+	table := metadata.Table{
+		Name: "job",
+		Columns: []metadata.Column{
+			{Name: "id", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+			{Name: "deleted", DataType: metadata.DataType{Name: "boolean", Kind: metadata.BaseType}},
+			{Name: "deleted_at", IsNullable: true, DataType: metadata.DataType{Name: "timestamp", Kind: metadata.BaseType}},
+			{Name: "removed_on", IsNullable: true, DataType: metadata.DataType{Name: "date", Kind: metadata.BaseType}},
+		},
+	}
+
+	tableSQLBuilder := DefaultTableSQLBuilder(table)
+	require.Equal(t, "deleted_at", tableSQLBuilder.SoftDeleteColumn)
+	require.Equal(t, &TableSQLBuilderColumn{Name: "DeletedAt", Type: "Timestamp"}, tableSQLBuilder.softDeleteColumn(table))
+
+	tableSQLBuilder = tableSQLBuilder.UseSoftDeleteColumn("removed_on")
+	require.Equal(t, &TableSQLBuilderColumn{Name: "RemovedOn", Type: "Date"}, tableSQLBuilder.softDeleteColumn(table))
+
+	require.False(t, tableSQLBuilder.SoftDeleteScope)
+	require.True(t, tableSQLBuilder.UseSoftDeleteScope(true).SoftDeleteScope)
+
+	require.Nil(t, tableSQLBuilder.UseSoftDeleteColumn("").softDeleteColumn(table))
+	require.Nil(t, tableSQLBuilder.UseSoftDeleteColumn("missing").softDeleteColumn(table))
+
+	table.Columns = table.Columns[:2]
+	require.Equal(t, "deleted", DefaultTableSQLBuilder(table).SoftDeleteColumn)
+	table.Columns = table.Columns[:1]
+	require.Equal(t, "", DefaultTableSQLBuilder(table).SoftDeleteColumn)
+}
+
+func TestTableMutableColumnsSkipGenerated(t *testing.T) {
+	table := metadata.Table{
+		Name: "invoice",
+		Columns: []metadata.Column{
+			{Name: "id", IsPrimaryKey: true, IsGenerated: true},
+			{Name: "amount"},
+			{Name: "amount_with_tax", IsGenerated: true},
+		},
+	}
+
+	require.Equal(t, []metadata.Column{{Name: "amount"}}, table.MutableColumns())
+}
+
+func TestGetSqlBuilderColumnTypeHStore(t *testing.T) {
+	require.Equal(t, "HStore", getSqlBuilderColumnType(metadata.Column{
+		Name:     "attributes",
+		DataType: metadata.DataType{Name: "hstore", Kind: metadata.UserDefinedType},
+	}))
+	require.Equal(t, "String", getSqlBuilderColumnType(metadata.Column{
+		Name:     "location",
+		DataType: metadata.DataType{Name: "geometry", Kind: metadata.UserDefinedType},
+	}))
+}
+
+func TestTableSQLBuilderInsertModelReturningPrimaryKeyDefaults(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet-generator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	tables := []metadata.Table{
+		{
+			Name: "account",
+			Columns: []metadata.Column{
+				{Name: "id", IsPrimaryKey: true, HasDefault: true, DataType: metadata.DataType{Name: "uuid", Kind: metadata.BaseType}},
+				{Name: "email", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+			},
+		},
+		{
+			Name: "country",
+			Columns: []metadata.Column{
+				{Name: "code", IsPrimaryKey: true, DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+			},
+		},
+	}
+
+	processTableSQLBuilder("table", dirPath, postgres.Dialect, metadata.Schema{Name: "public"}, tables, DefaultSQLBuilder())
+
+	account, err := ioutil.ReadFile(path.Join(dirPath, "table", "account.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(account), `
+// INSERT_MODEL creates new INSERT statement for all mutable columns, with values taken from the model.
+// Model fields are matched with columns using the same rules as query result mapping.
+// Columns with database default value are omitted if corresponding model field is nil pointer.
+// Primary key values generated by database are returned with RETURNING clause, and can be scanned back into the model.
+func (a AccountTable) INSERT_MODEL(model interface{}) postgres.InsertStatement {
+	return a.INSERT(a.MutableColumns.ExceptZeroDefaults(model)).MODEL(model).
+		RETURNING(a.ID)
+}
+`)
+
+	country, err := ioutil.ReadFile(path.Join(dirPath, "table", "country.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(country), `
+// Columns with database default value are omitted if corresponding model field is nil pointer.
+func (a CountryTable) INSERT_MODEL(model interface{}) postgres.InsertStatement {
+	return a.INSERT(a.MutableColumns.ExceptZeroDefaults(model)).MODEL(model)
+}
+`)
+}
+
+func TestTableSQLBuilderInsertOrIgnore(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet-generator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	tables := []metadata.Table{
+		{
+			Name: "country",
+			Columns: []metadata.Column{
+				{Name: "code", IsPrimaryKey: true, DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+				{Name: "name", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+			},
+		},
+		{
+			Name: "event_log",
+			Columns: []metadata.Column{
+				{Name: "message", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+			},
+		},
+	}
+
+	processTableSQLBuilder("table", path.Join(dirPath, "postgres"), postgres.Dialect, metadata.Schema{Name: "public"}, tables, DefaultSQLBuilder())
+	processTableSQLBuilder("table", path.Join(dirPath, "mysql"), mysql.Dialect, metadata.Schema{Name: "public"}, tables, DefaultSQLBuilder())
+
+	country, err := ioutil.ReadFile(path.Join(dirPath, "postgres", "table", "country.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(country), `
+// InsertOrIgnore creates new INSERT statement from the model, which does nothing if the row conflicts with existing one on primary key.
+func (a CountryTable) InsertOrIgnore(model interface{}) postgres.InsertStatement {
+	return a.INSERT_MODEL(model).ON_CONFLICT(a.PrimaryKey()...).DO_NOTHING()
+}
+`)
+
+	country, err = ioutil.ReadFile(path.Join(dirPath, "mysql", "table", "country.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(country), `
+// InsertOrIgnore creates new INSERT IGNORE statement from the model, which skips the row if it conflicts with existing one.
+func (a CountryTable) InsertOrIgnore(model interface{}) mysql.InsertStatement {
+	return a.INSERT_MODEL(model).IGNORE()
+}
+`)
+
+	for _, dialect := range []string{"postgres", "mysql"} {
+		eventLog, err := ioutil.ReadFile(path.Join(dirPath, dialect, "table", "event_log.go"))
+		require.NoError(t, err)
+		require.NotContains(t, string(eventLog), "InsertOrIgnore")
+	}
+}
+
+func TestTableSQLBuilderDefaultColumns(t *testing.T) {
+	dirPath, err := ioutil.TempDir("", "jet-generator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dirPath)
+
+	table := metadata.Table{
+		Name: "document",
+		Columns: []metadata.Column{
+			{Name: "id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+			{Name: "title", DataType: metadata.DataType{Name: "character varying", Kind: metadata.BaseType}},
+			{Name: "body", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+			{Name: "attributes", DataType: metadata.DataType{Name: "jsonb", Kind: metadata.BaseType}},
+			{Name: "thumbnail", DataType: metadata.DataType{Name: "BYTEA", Kind: metadata.BaseType}},
+		},
+	}
+
+	tableSQLBuilder := DefaultTableSQLBuilder(table)
+	require.Equal(t, []string{"id", "title", "body"}, columnNames(tableSQLBuilder.defaultColumns(table)))
+	require.Equal(t, []string{"id", "title", "attributes", "thumbnail"},
+		columnNames(tableSQLBuilder.UseLargeColumnTypes("text").defaultColumns(table)))
+	require.Len(t, tableSQLBuilder.UseLargeColumnTypes().defaultColumns(table), 5)
+
+	processTableSQLBuilder("table", path.Join(dirPath, "postgres"), postgres.Dialect, metadata.Schema{Name: "public"},
+		[]metadata.Table{table}, DefaultSQLBuilder())
+
+	document, err := ioutil.ReadFile(path.Join(dirPath, "postgres", "table", "document.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(document), `
+	AllColumns     postgres.ColumnList
+	MutableColumns postgres.ColumnList
+	DefaultColumns postgres.ColumnList
+}`)
+	require.Contains(t, string(document), `
+		defaultColumns   = postgres.ColumnList{IDColumn, TitleColumn, BodyColumn}`)
+	require.Contains(t, string(document), `
+		DefaultColumns: defaultColumns,`)
+}
+
+func columnNames(columns []metadata.Column) []string {
+	var ret []string
+
+	for _, column := range columns {
+		ret = append(ret, column.Name)
+	}
+
+	return ret
+}
+
+func TestGenerateTableSQLBuilderCompositePrimaryKey(t *testing.T) {
+	table := metadata.Table{
+		Name: "film_actor",
+		Columns: []metadata.Column{
+			{Name: "actor_id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "smallint", Kind: metadata.BaseType}},
+			{Name: "film_id", IsPrimaryKey: true, DataType: metadata.DataType{Name: "uuid", Kind: metadata.BaseType}},
+			{Name: "valid_from", IsPrimaryKey: true, DataType: metadata.DataType{Name: "date", Kind: metadata.BaseType}},
+			{Name: "note", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+		},
+	}
+
+	text, err := generateTableSQLBuilder(postgres.Dialect, "dvds", table, DefaultTableSQLBuilder(table), false)
+	require.NoError(t, err)
+
+	text, err = format.Source(text)
+	require.NoError(t, err)
+
+	require.Contains(t, string(text), `
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/google/uuid"
+	"time"
+)`)
+	require.Contains(t, string(text), `
+// FilmActorPK is FilmActorTable composite primary key value
+type FilmActorPK struct {
+	ActorID   int16
+	FilmID    uuid.UUID
+	ValidFrom time.Time
+}`)
+	require.Contains(t, string(text), `
+func (a FilmActorTable) ByKey(key FilmActorPK) postgres.BoolExpression {
+	return a.ActorID.EQ(postgres.Int(int64(key.ActorID))).
+		AND(a.FilmID.EQ(postgres.UUID(key.FilmID))).
+		AND(a.ValidFrom.EQ(postgres.DateT(key.ValidFrom)))
+}`)
+
+	table.Columns = table.Columns[:1]
+	text, err = generateTableSQLBuilder(postgres.Dialect, "dvds", table, DefaultTableSQLBuilder(table), false)
+	require.NoError(t, err)
+	require.NotContains(t, string(text), "ByKey")
+}
+
+func TestGenerateViewSQLBuilder(t *testing.T) {
+	view := metadata.Table{
+		Name: "actor_info",
+		Columns: []metadata.Column{
+			{Name: "actor_id", DataType: metadata.DataType{Name: "smallint", Kind: metadata.BaseType}},
+			{Name: "film_info", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+		},
+	}
+
+	for _, dialect := range []jet.Dialect{postgres.Dialect, mysql.Dialect} {
+		text, err := generateTableSQLBuilder(dialect, "dvds", view, DefaultViewSQLBuilder(view), true)
+		require.NoError(t, err)
+		require.NotContains(t, string(text), "INSERT_MODEL")
+		require.NotContains(t, string(text), "InsertOrIgnore")
+		require.NotContains(t, string(text), "PrimaryKey")
+
+		// table without primary key
+		text, err = generateTableSQLBuilder(dialect, "dvds", view, DefaultTableSQLBuilder(view), false)
+		require.NoError(t, err)
+		require.Contains(t, string(text), "INSERT_MODEL")
+		require.NotContains(t, string(text), "PrimaryKey")
+
+		_, err = format.Source(text)
+		require.NoError(t, err)
+	}
+}
+
+func TestPrimaryKeyLiteral(t *testing.T) {
+	valueType := Type{Name: "time.Time", ImportPath: "time"}
+	require.Equal(t, "sqlite.DATETIME(key.CreatedAt)", primaryKeyLiteral("sqlite", "Timestamp", &valueType, "key.CreatedAt"))
+	require.Equal(t, "mysql.TimestampT(key.CreatedAt)", primaryKeyLiteral("mysql", "Timestamp", &valueType, "key.CreatedAt"))
+
+	valueType = NewType(toGoType(metadata.Column{DataType: metadata.DataType{Name: "interval", Kind: metadata.BaseType}}))
+	require.Equal(t, Type{Name: "postgres.Interval", ImportPath: "github.com/go-jet/jet/v2/postgres"}, valueType)
+	require.Equal(t, "postgres.IntervalT(key.Period)", primaryKeyLiteral("postgres", "Interval", &valueType, "key.Period"))
+
+	valueType = Type{Name: "string"}
+	require.Equal(t, "postgres.IntervalExp(postgres.String(key.Period))", primaryKeyLiteral("postgres", "Interval", &valueType, "key.Period"))
+	require.Equal(t, Type{Name: "string"}, valueType)
+}
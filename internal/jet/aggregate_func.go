@@ -0,0 +1,117 @@
+package jet
+
+// AggregateExpression is aggregate function expression whose aggregated values can be made distinct and ordered
+type AggregateExpression interface {
+	Expression
+
+	DISTINCT() AggregateExpression
+	ORDER_BY(orderBy ...OrderByClause) AggregateExpression
+}
+
+// StringAggregateExpression is string aggregate function expression whose aggregated values can be made distinct and ordered
+type StringAggregateExpression interface {
+	StringExpression
+
+	DISTINCT() StringAggregateExpression
+	ORDER_BY(orderBy ...OrderByClause) StringAggregateExpression
+}
+
+// ARRAY_AGG collects input values, including nulls, into an array
+func ARRAY_AGG(expression Expression) AggregateExpression {
+	return newAggregateFunc("ARRAY_AGG", expression)
+}
+
+// STRING_AGG concatenates non-null input values into a string, separated by delimiter
+func STRING_AGG(expression StringExpression, delimiter StringExpression) StringAggregateExpression {
+	return newStringAggregateFunc("STRING_AGG", expression, delimiter)
+}
+
+// aggregateFuncImpl serializes aggregate function as: NAME([DISTINCT] expression [, params...] [ORDER BY ...])
+type aggregateFuncImpl struct {
+	ExpressionInterfaceImpl
+
+	name       string
+	distinct   bool
+	expression Expression
+	params     []Expression
+	orderBy    []OrderByClause
+}
+
+func (a *aggregateFuncImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if out.projectionScan != nil {
+		out.projectionScan.aggregate = true
+	}
+
+	out.WriteString(a.name + "(")
+
+	if a.distinct {
+		out.WriteString("DISTINCT")
+	}
+
+	serializeExpressionList(statement, parameters(append([]Expression{a.expression}, a.params...)), ", ", out)
+
+	if len(a.orderBy) > 0 {
+		orderBy := &ClauseOrderBy{List: a.orderBy, SkipNewLine: true}
+		orderBy.Serialize(statement, out)
+	}
+
+	out.WriteString(")")
+}
+
+type aggregateFunc struct {
+	aggregateFuncImpl
+}
+
+func newAggregateFunc(name string, expression Expression, params ...Expression) *aggregateFunc {
+	aggregateFunc := &aggregateFunc{
+		aggregateFuncImpl: aggregateFuncImpl{
+			name:       name,
+			expression: expression,
+			params:     params,
+		},
+	}
+
+	aggregateFunc.ExpressionInterfaceImpl.Parent = aggregateFunc
+
+	return aggregateFunc
+}
+
+func (a *aggregateFunc) DISTINCT() AggregateExpression {
+	a.distinct = true
+	return a
+}
+
+func (a *aggregateFunc) ORDER_BY(orderBy ...OrderByClause) AggregateExpression {
+	a.orderBy = orderBy
+	return a
+}
+
+type stringAggregateFunc struct {
+	aggregateFuncImpl
+	stringInterfaceImpl
+}
+
+func newStringAggregateFunc(name string, expression Expression, params ...Expression) *stringAggregateFunc {
+	stringAggregateFunc := &stringAggregateFunc{
+		aggregateFuncImpl: aggregateFuncImpl{
+			name:       name,
+			expression: expression,
+			params:     params,
+		},
+	}
+
+	stringAggregateFunc.ExpressionInterfaceImpl.Parent = stringAggregateFunc
+	stringAggregateFunc.stringInterfaceImpl.parent = stringAggregateFunc
+
+	return stringAggregateFunc
+}
+
+func (s *stringAggregateFunc) DISTINCT() StringAggregateExpression {
+	s.distinct = true
+	return s
+}
+
+func (s *stringAggregateFunc) ORDER_BY(orderBy ...OrderByClause) StringAggregateExpression {
+	s.orderBy = orderBy
+	return s
+}
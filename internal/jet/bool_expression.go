@@ -111,6 +111,10 @@ func newBoolExpressionWrap(expression Expression) BoolExpression {
 	return &boolExpressionWrap
 }
 
+func (b *boolExpressionWrapper) unwrap() Serializer {
+	return b.Expression
+}
+
 // BoolExp is bool expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as bool expression.
 // Does not add sql cast to generated sql builder output.
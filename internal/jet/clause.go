@@ -1,6 +1,7 @@
 package jet
 
 import (
+	"fmt"
 	"github.com/go-jet/jet/v2/internal/utils"
 )
 
@@ -79,18 +80,37 @@ func (f *ClauseFrom) Serialize(statementType StatementType, out *SQLBuilder, opt
 	out.DecreaseIdent()
 }
 
+const missingWhereClause = "jet: WHERE clause not set"
+
 // ClauseWhere struct
 type ClauseWhere struct {
 	Condition BoolExpression
 	Mandatory bool
+	// Scope is condition always ANDed with Condition. Scope does not satisfy Mandatory WHERE clause.
+	Scope BoolExpression
 }
 
 // Serialize serializes clause into SQLBuilder
 func (c *ClauseWhere) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
-	if c.Condition == nil {
-		if c.Mandatory {
-			panic("jet: WHERE clause not set")
+	condition := c.Condition
+
+	if isEmptyBoolExpression(condition) { // nil, or AND/OR group without any expression
+		condition = nil
+	}
+
+	if condition == nil && c.Mandatory {
+		panic(missingWhereClause)
+	}
+
+	if !isEmptyBoolExpression(c.Scope) {
+		if condition == nil {
+			condition = c.Scope
+		} else {
+			condition = c.Scope.AND(condition)
 		}
+	}
+
+	if condition == nil {
 		return
 	}
 	if !contains(options, SkipNewLine) {
@@ -99,17 +119,31 @@ func (c *ClauseWhere) Serialize(statementType StatementType, out *SQLBuilder, op
 	out.WriteString("WHERE")
 
 	out.IncreaseIdent(6)
-	c.Condition.serialize(statementType, out, NoWrap.WithFallTrough(options)...)
+	condition.serialize(statementType, out, NoWrap.WithFallTrough(options)...)
 	out.DecreaseIdent(6)
 }
 
 // ClauseGroupBy struct
 type ClauseGroupBy struct {
 	List []GroupByClause
+	// All, if set, groups by all non-aggregate projections of the select clause (GROUP BY ALL). Dialects without
+	// GROUP BY ALL support get the list of non-aggregate projections expanded instead.
+	All *ClauseSelect
 }
 
 // Serialize serializes clause into SQLBuilder
 func (c *ClauseGroupBy) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if c.All != nil {
+		if serializeOverride := out.Dialect.OperatorSerializeOverride("GROUP BY ALL"); serializeOverride != nil {
+			serializeOverride()(statementType, out, FallTrough(options)...)
+			return
+		}
+
+		groupByAll := ClauseGroupBy{List: nonAggregateProjections(out.Dialect, statementType, c.All.ProjectionList)}
+		groupByAll.Serialize(statementType, out, options...)
+		return
+	}
+
 	if len(c.List) == 0 {
 		return
 	}
@@ -134,14 +168,59 @@ func (c *ClauseGroupBy) Serialize(statementType StatementType, out *SQLBuilder,
 	out.DecreaseIdent()
 }
 
+// nonAggregateProjections returns group by clauses for projections which reference table columns, but do not contain
+// aggregate or window function calls. Aliased projections are grouped by aliased expression.
+func nonAggregateProjections(dialect Dialect, statementType StatementType, projections []Projection) []GroupByClause {
+	var ret []GroupByClause
+
+	for _, projection := range flattenProjections(projections) {
+		var groupByClause GroupByClause
+
+		switch p := projection.(type) {
+		case *alias:
+			groupByClause = p.expression
+		case Statement:
+			continue
+		case Expression:
+			groupByClause = p
+		default:
+			continue
+		}
+
+		scan := scanSerialization(dialect, func(out *SQLBuilder) {
+			groupByClause.serializeForGroupBy(statementType, out)
+		})
+
+		if scan.column && !scan.aggregate && !scan.window {
+			ret = append(ret, groupByClause)
+		}
+	}
+
+	return ret
+}
+
 // ClauseHaving struct
 type ClauseHaving struct {
 	Condition BoolExpression
 }
 
+// Append ANDs condition to existing HAVING condition, or sets it if HAVING condition is not set. Nil condition is ignored.
+func (c *ClauseHaving) Append(condition BoolExpression) {
+	if condition == nil {
+		return
+	}
+
+	if c.Condition == nil {
+		c.Condition = condition
+		return
+	}
+
+	c.Condition = c.Condition.AND(condition)
+}
+
 // Serialize serializes clause into SQLBuilder
 func (c *ClauseHaving) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
-	if c.Condition == nil {
+	if isEmptyBoolExpression(c.Condition) {
 		return
 	}
 
@@ -157,6 +236,11 @@ func (c *ClauseHaving) Serialize(statementType StatementType, out *SQLBuilder, o
 type ClauseOrderBy struct {
 	List        []OrderByClause
 	SkipNewLine bool
+
+	// Select and GroupBy are set for SELECT statement ORDER BY clause. Aggregates aliased in select clause are then
+	// ordered by alias, if dialect supports it, and ordering by aggregate is validated against GROUP BY clause.
+	Select  *ClauseSelect
+	GroupBy *ClauseGroupBy
 }
 
 // Serialize serializes clause into SQLBuilder
@@ -165,6 +249,10 @@ func (o *ClauseOrderBy) Serialize(statementType StatementType, out *SQLBuilder,
 		return
 	}
 
+	if o.Select != nil {
+		o.mustMatchGroupBy(statementType, out.Dialect)
+	}
+
 	if !o.SkipNewLine {
 		out.NewLine()
 	}
@@ -177,19 +265,102 @@ func (o *ClauseOrderBy) Serialize(statementType StatementType, out *SQLBuilder,
 			out.WriteString(", ")
 		}
 
+		if o.Select != nil && out.Dialect.OrderByAlias() {
+			value = orderByAggregateAlias(statementType, out.Dialect, value, o.Select.ProjectionList)
+		}
+
 		value.serializeForOrderBy(statementType, out)
 	}
 
 	out.DecreaseIdent()
 }
 
+// Append appends orderByClauses to the list of clauses. Clause ordering by a column already present in the list is skipped.
+func (o *ClauseOrderBy) Append(orderByClauses ...OrderByClause) {
+	for _, orderByClause := range orderByClauses {
+		if column := orderByColumn(orderByClause); column != nil && o.hasColumn(column) {
+			continue
+		}
+
+		o.List = append(o.List, orderByClause)
+	}
+}
+
+func (o *ClauseOrderBy) hasColumn(column Column) bool {
+	for _, orderByClause := range o.List {
+		existing := orderByColumn(orderByClause)
+
+		if existing != nil && sameColumn(existing, column) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mustMatchGroupBy panics if select statement without GROUP BY clause is ordered by aggregate, while select clause has
+// projections which are neither aggregates nor window functions.
+func (o *ClauseOrderBy) mustMatchGroupBy(statementType StatementType, dialect Dialect) {
+	if o.GroupBy != nil && (len(o.GroupBy.List) > 0 || o.GroupBy.All != nil) {
+		return
+	}
+
+	orderByAggregate := false
+
+	for _, orderByClause := range o.List {
+		if orderByClause == nil {
+			continue
+		}
+
+		scan := scanSerialization(dialect, func(out *SQLBuilder) {
+			orderByClause.serializeForOrderBy(statementType, out)
+		})
+
+		if scan.aggregate {
+			orderByAggregate = true
+			break
+		}
+	}
+
+	if !orderByAggregate {
+		return
+	}
+
+	for _, projection := range flattenProjections(o.Select.ProjectionList) {
+		groupByClause, ok := projection.(GroupByClause)
+
+		if !ok {
+			continue
+		}
+
+		scan := scanSerialization(dialect, func(out *SQLBuilder) {
+			groupByClause.serializeForGroupBy(statementType, out)
+		})
+
+		if scan.column && !scan.aggregate && !scan.window {
+			panic("jet: ORDER BY aggregate requires GROUP BY clause, if SELECT clause has non-aggregate projections")
+		}
+	}
+}
+
 // ClauseLimit struct
 type ClauseLimit struct {
 	Count int64
+	All   bool
 }
 
 // Serialize serializes clause into SQLBuilder
 func (l *ClauseLimit) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if l.All {
+		out.NewLine()
+		if serializeOverride := out.Dialect.OperatorSerializeOverride("LIMIT ALL"); serializeOverride != nil {
+			serializeOverride()(statementType, out, options...)
+			return
+		}
+		out.WriteString("LIMIT ALL")
+		return
+	}
+
 	if l.Count >= 0 {
 		out.NewLine()
 		out.WriteString("LIMIT")
@@ -252,6 +423,8 @@ func (s *ClauseSetStmtOperator) Serialize(statementType StatementType, out *SQLB
 		panic("jet: UNION Statement must contain at least two SELECT statements")
 	}
 
+	s.checkColumnCount()
+
 	for i, selectStmt := range s.Selects {
 		out.NewLine()
 		if i > 0 {
@@ -283,6 +456,47 @@ func (s *ClauseSetStmtOperator) Serialize(statementType StatementType, out *SQLB
 	s.Offset.Serialize(statementType, out)
 }
 
+// checkColumnCount panics if set operator queries do not have the same number of projected columns. Queries whose
+// number of columns can not be determined (raw statements and star projections) are not checked.
+func (s *ClauseSetStmtOperator) checkColumnCount() {
+	firstCount := -1
+
+	for i, selectStmt := range s.Selects {
+		if utils.IsNil(selectStmt) {
+			continue
+		}
+
+		count := projectionCount(selectStmt.projections())
+
+		if count <= 0 {
+			continue
+		}
+
+		if firstCount < 0 {
+			firstCount = count
+			continue
+		}
+
+		if count != firstCount {
+			panic(fmt.Sprintf("jet: each %s query must have the same number of columns, first query has %d columns, "+
+				"but query %d has %d columns", s.Operator, firstCount, i+1, count))
+		}
+	}
+}
+
+// projectionCount returns number of columns projected by the list, or -1 if list contains star projection
+func projectionCount(projections ProjectionList) int {
+	flattened := flattenProjections(projections)
+
+	for _, projection := range flattened {
+		if projection == STAR {
+			return -1
+		}
+	}
+
+	return len(flattened)
+}
+
 // ClauseUpdate struct
 type ClauseUpdate struct {
 	Table SerializerTable
@@ -329,6 +543,8 @@ func (s *SetClause) Serialize(statementType StatementType, out *SQLBuilder, opti
 			panic("jet: nil column in columns list for SET clause")
 		}
 
+		MustBeAssignable(column)
+
 		out.WriteIdentifier(column.Name())
 
 		out.WriteString(" = ")
@@ -340,13 +556,26 @@ func (s *SetClause) Serialize(statementType StatementType, out *SQLBuilder, opti
 
 // ClauseInsert struct
 type ClauseInsert struct {
+	// Name is statement beginning, INSERT INTO if not set
+	Name    string
 	Table   SerializerTable
 	Columns []Column
+	// AllowGenerated allows generated columns in the insert column list (for instance with OVERRIDING SYSTEM VALUE)
+	AllowGenerated bool
+	// EmptyColumnList is set if insert column list is given, but it is empty (for instance, every column is omitted by
+	// ColumnList.ExceptZeroDefaults). Rows inserted from models are then rows of column default values.
+	EmptyColumnList bool
+}
+
+// SetColumns sets insert column list, with column lists unwound
+func (i *ClauseInsert) SetColumns(columns []Column) {
+	i.Columns = UnwidColumnList(columns)
+	i.EmptyColumnList = len(columns) > 0 && len(i.Columns) == 0
 }
 
 // GetColumns gets list of columns for insert
 func (i *ClauseInsert) GetColumns() []Column {
-	if len(i.Columns) > 0 {
+	if len(i.Columns) > 0 || i.EmptyColumnList {
 		return i.Columns
 	}
 
@@ -356,7 +585,12 @@ func (i *ClauseInsert) GetColumns() []Column {
 // Serialize serializes clause into SQLBuilder
 func (i *ClauseInsert) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
 	out.NewLine()
-	out.WriteString("INSERT INTO")
+
+	if len(i.Name) > 0 {
+		out.WriteString(i.Name)
+	} else {
+		out.WriteString("INSERT INTO")
+	}
 
 	if utils.IsNil(i.Table) {
 		panic("jet: table is nil for INSERT clause")
@@ -365,12 +599,18 @@ func (i *ClauseInsert) Serialize(statementType StatementType, out *SQLBuilder, o
 	i.Table.serialize(statementType, out)
 
 	if len(i.Columns) > 0 {
+		if !i.AllowGenerated {
+			MustBeAssignable(i.Columns...)
+		}
+
 		out.WriteString("(")
 
 		SerializeColumnNames(i.Columns, out)
 
 		out.WriteString(")")
 	}
+
+	out.insertColumns = i.GetColumns()
 }
 
 // ClauseValuesQuery struct
@@ -392,6 +632,9 @@ func (v *ClauseValuesQuery) Serialize(statementType StatementType, out *SQLBuild
 // ClauseValues struct
 type ClauseValues struct {
 	Rows [][]Serializer
+	// DefaultRow, if set, is serialized instead of single empty VALUES row, for dialects that do not support
+	// empty VALUES row, but can insert row of column default values, for instance with DEFAULT VALUES clause.
+	DefaultRow string
 }
 
 // Serialize serializes clause into SQLBuilder
@@ -400,6 +643,16 @@ func (v *ClauseValues) Serialize(statementType StatementType, out *SQLBuilder, o
 		return
 	}
 
+	if v.DefaultRow != "" && len(v.Rows[0]) == 0 {
+		if len(v.Rows) > 1 {
+			panic("jet: multiple rows of column default values can not be inserted with " + v.DefaultRow)
+		}
+
+		out.NewLine()
+		out.WriteString(v.DefaultRow)
+		return
+	}
+
 	out.NewLine()
 	out.WriteString("VALUES")
 
@@ -413,7 +666,21 @@ func (v *ClauseValues) Serialize(statementType StatementType, out *SQLBuilder, o
 
 		out.WriteString("(")
 
-		SerializeClauseList(statementType, row, out)
+		for i, value := range row {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+
+			if value == nil {
+				panic("jet: nil clause")
+			}
+
+			if i < len(out.insertColumns) {
+				out.parameterName = out.insertColumns[i].Name()
+			}
+
+			value.serialize(statementType, out)
+		}
 
 		out.WriteByte(')')
 	}
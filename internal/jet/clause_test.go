@@ -14,3 +14,43 @@ func TestClauseSelect_Serialize(t *testing.T) {
 	selectClause := &ClauseSelect{}
 	selectClause.Serialize(SelectStatementType, &SQLBuilder{})
 }
+
+func TestClauseGroupByAll(t *testing.T) {
+	selectClause := &ClauseSelect{ProjectionList: []Projection{
+		table1Col1,
+		ProjectionList{table1ColFloat, COUNT(table1ColInt).AS("count")},
+		table1ColInt.ADD(Int(1)).AS("next"),
+		SUMi(table1ColInt).OVER().AS("total"),
+		String("label").AS("label"),
+	}}
+
+	groupBy := &ClauseGroupBy{All: selectClause}
+
+	out := SQLBuilder{Dialect: defaultDialect}
+	groupBy.Serialize(SelectStatementType, &out)
+	require.Equal(t, `
+GROUP BY table1.col1, table1.col_float, table1.col_int + $1`, out.Buff.String())
+	require.Equal(t, []interface{}{int64(1)}, out.Args)
+
+	groupByAllDialect := NewDialect(DialectParams{
+		Name:                "GroupByAllDB",
+		ArgumentPlaceholder: defaultDialect.ArgumentPlaceholder(),
+		OperatorSerializeOverrides: map[string]SerializeOverride{
+			"GROUP BY ALL": func(expressions ...Serializer) SerializerFunc {
+				return func(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+					out.NewLine()
+					out.WriteString("GROUP BY ALL")
+				}
+			},
+		},
+	})
+
+	out = SQLBuilder{Dialect: groupByAllDialect}
+	groupBy.Serialize(SelectStatementType, &out)
+	require.Equal(t, "\nGROUP BY ALL", out.Buff.String())
+
+	// only aggregates in select clause
+	out = SQLBuilder{Dialect: defaultDialect}
+	(&ClauseGroupBy{All: &ClauseSelect{ProjectionList: []Projection{COUNT(STAR)}}}).Serialize(SelectStatementType, &out)
+	require.Equal(t, "", out.Buff.String())
+}
@@ -9,6 +9,13 @@ type Column interface {
 
 	setTableName(table string)
 	setSubQuery(subQuery SelectTable)
+	// IsGenerated returns true if column is generated (GENERATED ALWAYS identity or computed) column.
+	// Generated columns can be read, but values can not be assigned to them.
+	IsGenerated() bool
+
+	setGenerated(generated bool)
+	setHasDefault(hasDefault bool)
+	hasDefaultValue() bool
 	defaultAlias() string
 }
 
@@ -28,8 +35,10 @@ type ColumnExpression interface {
 type ColumnExpressionImpl struct {
 	ExpressionInterfaceImpl
 
-	name      string
-	tableName string
+	name       string
+	tableName  string
+	generated  bool
+	hasDefault bool
 
 	subQuery SelectTable
 }
@@ -68,6 +77,23 @@ func (c *ColumnExpressionImpl) setSubQuery(subQuery SelectTable) {
 	c.subQuery = subQuery
 }
 
+func (c *ColumnExpressionImpl) setGenerated(generated bool) {
+	c.generated = generated
+}
+
+// IsGenerated returns true if column is generated column
+func (c *ColumnExpressionImpl) IsGenerated() bool {
+	return c.generated
+}
+
+func (c *ColumnExpressionImpl) setHasDefault(hasDefault bool) {
+	c.hasDefault = hasDefault
+}
+
+func (c *ColumnExpressionImpl) hasDefaultValue() bool {
+	return c.hasDefault
+}
+
 func (c *ColumnExpressionImpl) defaultAlias() string {
 	if c.tableName != "" {
 		return c.tableName + "." + c.name
@@ -101,12 +127,20 @@ func (c ColumnExpressionImpl) serializeForProjection(statement StatementType, ou
 }
 
 func (c ColumnExpressionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if out.projectionScan != nil {
+		out.projectionScan.column = true
+	}
+
+	out.parameterName = c.name
 
 	if c.subQuery != nil {
+		out.columnScope.addColumn(c.subQuery.Alias(), c.defaultAlias())
 		out.WriteIdentifier(c.subQuery.Alias())
 		out.WriteByte('.')
 		out.WriteIdentifier(c.defaultAlias())
 	} else {
+		out.columnScope.addColumn(c.tableName, c.name)
+
 		if c.tableName != "" && !contains(options, ShortName) {
 			out.WriteIdentifier(c.tableName)
 			out.WriteByte('.')
@@ -115,3 +149,47 @@ func (c ColumnExpressionImpl) serialize(statement StatementType, out *SQLBuilder
 		out.WriteIdentifier(c.name)
 	}
 }
+
+// NewExpressionColumn creates new column named name, whose value is computed by expression. Expression column
+// can be used as any other column, and it is exported in projection list as if it is a real table column.
+func NewExpressionColumn(name string, expression Expression) ColumnExpression {
+	expressionColumn := &expressionColumnImpl{expression: expression}
+	expressionColumn.ColumnExpressionImpl = NewColumnImpl(name, "", expressionColumn)
+
+	return expressionColumn
+}
+
+type expressionColumnImpl struct {
+	ColumnExpressionImpl
+
+	expression Expression
+}
+
+func (e *expressionColumnImpl) serializeForOrderBy(statement StatementType, out *SQLBuilder) {
+	if statement == SetStatementType {
+		out.WriteAlias(e.defaultAlias())
+		return
+	}
+
+	e.serialize(statement, out)
+}
+
+func (e *expressionColumnImpl) serializeForProjection(statement StatementType, out *SQLBuilder) {
+	e.serialize(statement, out)
+
+	out.WriteString("AS")
+	out.WriteAlias(e.defaultAlias())
+}
+
+func (e *expressionColumnImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if e.expression == nil {
+		panic("jet: expression column '" + e.name + "' expression is nil")
+	}
+
+	if e.subQuery != nil {
+		e.ColumnExpressionImpl.serialize(statement, out, options...)
+		return
+	}
+
+	e.expression.serialize(statement, out, options...)
+}
@@ -1,5 +1,7 @@
 package jet
 
+import "fmt"
+
 // ColumnAssigment is interface wrapper around column assigment
 type ColumnAssigment interface {
 	Serializer
@@ -14,7 +16,26 @@ type columnAssigmentImpl struct {
 func (a columnAssigmentImpl) isColumnAssigment() {}
 
 func (a columnAssigmentImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	MustBeAssignable(a.column)
+
 	a.column.serialize(statement, out, ShortName.WithFallTrough(options)...)
 	out.WriteString("=")
 	a.expression.serialize(statement, out, FallTrough(options)...)
 }
+
+// NewNoOpColumnAssigment creates assigment of the column to itself (column = column), which does not change column value
+func NewNoOpColumnAssigment(column ColumnExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     column,
+		expression: column,
+	}
+}
+
+// MustBeAssignable panics if any of the columns is generated column, because values can not be assigned to generated columns
+func MustBeAssignable(columns ...Column) {
+	for _, col := range UnwidColumnList(columns) {
+		if col != nil && col.IsGenerated() {
+			panic(fmt.Sprintf("jet: can't assign value to generated column '%s'", col.Name()))
+		}
+	}
+}
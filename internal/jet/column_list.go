@@ -1,5 +1,11 @@
 package jet
 
+import (
+	"fmt"
+	"github.com/go-jet/jet/v2/internal/utils"
+	"reflect"
+)
+
 // ColumnList is a helper type to support list of columns as single projection
 type ColumnList []ColumnExpression
 
@@ -33,6 +39,77 @@ func (cl ColumnList) Except(excludedColumns ...Column) ColumnList {
 	return ret
 }
 
+// ByFieldNames will create new column list with only the columns mapped to model struct fieldNames. Field is mapped
+// to a column the same way as in ColumnsOf, by the name derived from column name (for instance, first_name column
+// is mapped to FirstName field) or by the 'alias' tag. Useful for partial updates, when only the changed model fields
+// should be updated.
+func (cl ColumnList) ByFieldNames(model interface{}, fieldNames ...string) ColumnList {
+	structType := reflect.TypeOf(model)
+
+	for structType != nil && structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+
+	if structType == nil || structType.Kind() != reflect.Struct {
+		panic("jet: model has to be a struct or a pointer to struct")
+	}
+
+	columnsToInclude := map[ColumnExpression]bool{}
+
+	for _, fieldName := range fieldNames {
+		field, ok := structType.FieldByName(fieldName)
+
+		if !ok {
+			panic(fmt.Sprintf("jet: model has no field '%s'", fieldName))
+		}
+
+		column := columnForField(cl, field)
+
+		if column == nil {
+			panic(fmt.Sprintf("jet: column for field '%s' not found", fieldName))
+		}
+
+		columnsToInclude[column] = true
+	}
+
+	var ret ColumnList
+
+	for _, column := range cl {
+		if columnsToInclude[column] {
+			ret = append(ret, column)
+		}
+	}
+
+	return ret
+}
+
+// ExceptZeroDefaults will create new column list without the columns having database default value, for which
+// corresponding model field is nil pointer. Values of omitted columns will be set by database defaults on INSERT.
+// Non-pointer fields and non-nil pointer fields are considered explicitly set, even if they are zero values, so explicit
+// false or 0 is never replaced with column default. If every column is omitted, INSERT statement inserts row of
+// column default values.
+func (cl ColumnList) ExceptZeroDefaults(model interface{}) ColumnList {
+	structValue := reflect.Indirect(reflect.ValueOf(model))
+
+	utils.ValueMustBe(structValue, reflect.Struct, "jet: model has to be a struct")
+
+	var ret ColumnList
+
+	for _, column := range cl {
+		if column.hasDefaultValue() {
+			structField := modelFieldForColumn(structValue, column.Name())
+
+			if structField.IsValid() && structField.Kind() == reflect.Ptr && structField.IsNil() {
+				continue
+			}
+		}
+
+		ret = append(ret, column)
+	}
+
+	return ret
+}
+
 func (cl ColumnList) fromImpl(subQuery SelectTable) Projection {
 	newProjectionList := ProjectionList{}
 
@@ -65,10 +142,16 @@ func (cl ColumnList) serializeForProjection(statement StatementType, out *SQLBui
 // Name is placeholder for ColumnList to implement Column interface
 func (cl ColumnList) Name() string { return "" }
 
+// IsGenerated is placeholder for ColumnList to implement Column interface
+func (cl ColumnList) IsGenerated() bool { return false }
+
 // TableName is placeholder for ColumnList to implement Column interface
 func (cl ColumnList) TableName() string                { return "" }
 func (cl ColumnList) setTableName(name string)         {}
 func (cl ColumnList) setSubQuery(subQuery SelectTable) {}
+func (cl ColumnList) setGenerated(generated bool)      {}
+func (cl ColumnList) setHasDefault(hasDefault bool)    {}
+func (cl ColumnList) hasDefaultValue() bool            { return false }
 func (cl ColumnList) defaultAlias() string             { return "" }
 
 // SetTableName is utility function to set table name from outside of jet package to avoid making public setTableName
@@ -76,6 +159,18 @@ func SetTableName(columnExpression ColumnExpression, tableName string) {
 	columnExpression.setTableName(tableName)
 }
 
+// SetGenerated is utility function to mark column as generated (GENERATED ALWAYS identity or computed column) from outside of jet package.
+// Values can not be assigned to generated columns.
+func SetGenerated(columnExpression ColumnExpression) {
+	columnExpression.setGenerated(true)
+}
+
+// SetHasDefault is utility function to mark column as having database default value from outside of jet package.
+// Zero model values of such columns can be omitted from INSERT with ColumnList.ExceptZeroDefaults.
+func SetHasDefault(columnExpression ColumnExpression) {
+	columnExpression.setHasDefault(true)
+}
+
 // SetSubQuery is utility function to set table name from outside of jet package to avoid making public setSubQuery
 func SetSubQuery(columnExpression ColumnExpression, subQuery SelectTable) {
 	columnExpression.setSubQuery(subQuery)
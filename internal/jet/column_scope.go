@@ -0,0 +1,89 @@
+package jet
+
+import "fmt"
+
+// columnScope collects names and aliases of the tables serialized in a (sub)statement, and qualified columns
+// referenced by the statement. Each sub-statement has its own scope, which also sees the tables of the outer scopes.
+type columnScope struct {
+	parent  *columnScope
+	tables  map[string]bool
+	columns []scopeColumn // columns of the whole statement, collected in the outermost scope only
+}
+
+type scopeColumn struct {
+	scope     *columnScope
+	tableName string
+	name      string
+}
+
+func newColumnScope(parent *columnScope) *columnScope {
+	return &columnScope{parent: parent, tables: map[string]bool{}}
+}
+
+// enter returns new scope nested in the current scope
+func (c *columnScope) enter() *columnScope {
+	if c == nil {
+		return nil
+	}
+
+	return newColumnScope(c)
+}
+
+// leave returns the scope enclosing the current scope
+func (c *columnScope) leave() *columnScope {
+	if c == nil {
+		return nil
+	}
+
+	return c.parent
+}
+
+func (c *columnScope) addTable(name string) {
+	if c == nil || name == "" {
+		return
+	}
+
+	c.tables[name] = true
+}
+
+func (c *columnScope) addColumn(tableName, name string) {
+	if c == nil || tableName == "" { // unqualified columns can not be resolved
+		return
+	}
+
+	root := c
+	for root.parent != nil {
+		root = root.parent
+	}
+
+	root.columns = append(root.columns, scopeColumn{scope: c, tableName: tableName, name: name})
+}
+
+// contains returns true if table is in the scope or in any of the enclosing scopes
+func (c *columnScope) contains(tableName string) bool {
+	for scope := c; scope != nil; scope = scope.parent {
+		if scope.tables[tableName] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateColumnScope dry-runs statement serialization and checks that every qualified column referenced by the
+// statement belongs to a table, sub-query or CTE serialized in the same statement or in one of the enclosing
+// statements. Error names the first column whose table is not in scope.
+func ValidateColumnScope(dialect Dialect, statement Serializer) error {
+	out := &SQLBuilder{Dialect: dialect, columnScope: newColumnScope(nil)}
+
+	statement.serialize(SelectStatementType, out)
+
+	for _, column := range out.columnScope.columns {
+		if !column.scope.contains(column.tableName) {
+			return fmt.Errorf("jet: column '%s.%s' references table '%s', which is not in the FROM clause",
+				column.tableName, column.name, column.tableName)
+		}
+	}
+
+	return nil
+}
@@ -12,3 +12,17 @@ func TestColumn(t *testing.T) {
 	assertProjectionSerialize(t, &column, `table1.col AS "table1.col"`)
 	assertProjectionSerialize(t, column.AS("alias1"), `table1.col AS "alias1"`)
 }
+
+func TestExpressionColumn(t *testing.T) {
+	column := NewExpressionColumn("col_sum", table1ColInt.ADD(table1Col3))
+
+	assertClauseSerialize(t, column, "(table1.col_int + table1.col3)")
+	assertProjectionSerialize(t, column, `(table1.col_int + table1.col3) AS "col_sum"`)
+	assertProjectionSerialize(t, column.AS("alias1"), `(table1.col_int + table1.col3) AS "alias1"`)
+	assertClauseSerialize(t, column.IS_NOT_NULL(), "(table1.col_int + table1.col3) IS NOT NULL")
+
+	subQuery := NewSelectTable(nil, "sub_query")
+	assertProjectionSerialize(t, column.fromImpl(subQuery), `sub_query.col_sum AS "col_sum"`)
+
+	assertClauseSerializeErr(t, NewExpressionColumn("col_nil", nil), "jet: expression column 'col_nil' expression is nil")
+}
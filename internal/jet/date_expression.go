@@ -85,6 +85,10 @@ func newDateExpressionWrap(expression Expression) DateExpression {
 	return &dateExpressionWrap
 }
 
+func (d *dateExpressionWrapper) unwrap() Serializer {
+	return d.Expression
+}
+
 // DateExp is date expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as date expression.
 // Does not add sql cast to generated sql builder output.
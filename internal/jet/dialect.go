@@ -12,6 +12,11 @@ type Dialect interface {
 	IdentifierQuoteChar() byte
 	ArgumentPlaceholder() QueryPlaceholderFunc
 	IsReservedWord(name string) bool
+	ArgumentToString(value interface{}) (string, bool)
+	// MaxParameters returns maximum number of parameters allowed in a single statement, or 0 if there is no limit.
+	MaxParameters() int
+	// OrderByAlias returns true if ORDER BY clause can reference select list projections by alias.
+	OrderByAlias() bool
 }
 
 // SerializerFunc func
@@ -23,6 +28,10 @@ type SerializeOverride func(expressions ...Serializer) SerializerFunc
 // QueryPlaceholderFunc func
 type QueryPlaceholderFunc func(ord int) string
 
+// ArgumentToStringFunc converts argument to dialect specific SQL literal. Returns false if value
+// is not handled, in which case default conversion is used.
+type ArgumentToStringFunc func(value interface{}) (string, bool)
+
 // DialectParams struct
 type DialectParams struct {
 	Name                       string
@@ -32,7 +41,12 @@ type DialectParams struct {
 	AliasQuoteChar             byte
 	IdentifierQuoteChar        byte
 	ArgumentPlaceholder        QueryPlaceholderFunc
+	ArgumentToString           ArgumentToStringFunc
 	ReservedWords              []string
+	MaxParameters              int
+	// OrderByAlias should be set if ORDER BY clause can reference select list projections by alias. Otherwise, ordering
+	// by aliased aggregate repeats aggregate expression.
+	OrderByAlias bool
 }
 
 // NewDialect creates new dialect with params
@@ -45,7 +59,10 @@ func NewDialect(params DialectParams) Dialect {
 		aliasQuoteChar:             params.AliasQuoteChar,
 		identifierQuoteChar:        params.IdentifierQuoteChar,
 		argumentPlaceholder:        params.ArgumentPlaceholder,
+		argumentToString:           params.ArgumentToString,
 		reservedWords:              arrayOfStringsToMapOfStrings(params.ReservedWords),
+		maxParameters:              params.MaxParameters,
+		orderByAlias:               params.OrderByAlias,
 	}
 }
 
@@ -57,7 +74,10 @@ type dialectImpl struct {
 	aliasQuoteChar             byte
 	identifierQuoteChar        byte
 	argumentPlaceholder        QueryPlaceholderFunc
+	argumentToString           ArgumentToStringFunc
 	reservedWords              map[string]bool
+	maxParameters              int
+	orderByAlias               bool
 
 	supportsReturning bool
 }
@@ -96,6 +116,21 @@ func (d *dialectImpl) ArgumentPlaceholder() QueryPlaceholderFunc {
 	return d.argumentPlaceholder
 }
 
+func (d *dialectImpl) ArgumentToString(value interface{}) (string, bool) {
+	if d.argumentToString == nil {
+		return "", false
+	}
+	return d.argumentToString(value)
+}
+
+func (d *dialectImpl) MaxParameters() int {
+	return d.maxParameters
+}
+
+func (d *dialectImpl) OrderByAlias() bool {
+	return d.orderByAlias
+}
+
 func (d *dialectImpl) IsReservedWord(name string) bool {
 	_, isReservedWord := d.reservedWords[strings.ToLower(name)]
 	return isReservedWord
@@ -0,0 +1,87 @@
+package jet
+
+// ExcludedBool creates reference to the bool column value proposed for insertion.
+func ExcludedBool(column ColumnBool) BoolExpression {
+	return BoolExp(newExcluded(column))
+}
+
+// ExcludedInteger creates reference to the integer column value proposed for insertion.
+func ExcludedInteger(column ColumnInteger) IntegerExpression {
+	return IntExp(newExcluded(column))
+}
+
+// ExcludedFloat creates reference to the float column value proposed for insertion.
+func ExcludedFloat(column ColumnFloat) FloatExpression {
+	return FloatExp(newExcluded(column))
+}
+
+// ExcludedString creates reference to the string column value proposed for insertion.
+func ExcludedString(column ColumnString) StringExpression {
+	return StringExp(newExcluded(column))
+}
+
+// ExcludedTime creates reference to the time column value proposed for insertion.
+func ExcludedTime(column ColumnTime) TimeExpression {
+	return TimeExp(newExcluded(column))
+}
+
+// ExcludedDate creates reference to the date column value proposed for insertion.
+func ExcludedDate(column ColumnDate) DateExpression {
+	return DateExp(newExcluded(column))
+}
+
+// ExcludedTimestamp creates reference to the timestamp column value proposed for insertion.
+func ExcludedTimestamp(column ColumnTimestamp) TimestampExpression {
+	return TimestampExp(newExcluded(column))
+}
+
+// newExcluded creates reference to the column value proposed for insertion. Excluded column can be used only inside
+// conflict update clause. If conflict update clause has row alias, column is serialized as alias.column, otherwise
+// dialect EXCLUDED operator override is used (VALUES(column) for MySQL).
+func newExcluded(column ColumnExpression) Expression {
+	excluded := &excludedExpression{column: column}
+	excluded.ExpressionInterfaceImpl.Parent = excluded
+
+	return excluded
+}
+
+type excludedExpression struct {
+	ExpressionInterfaceImpl
+
+	column ColumnExpression
+}
+
+func (e *excludedExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if !out.conflictUpdate {
+		panic("jet: Excluded column can be used only in INSERT conflict update clause")
+	}
+
+	if out.conflictRowAlias == "" {
+		if serializeOverride := out.Dialect.OperatorSerializeOverride("EXCLUDED"); serializeOverride != nil {
+			serializeOverride(e.column)(statement, out, FallTrough(options)...)
+			return
+		}
+	}
+
+	rowAlias := out.conflictRowAlias
+	if rowAlias == "" {
+		rowAlias = "excluded"
+	}
+
+	out.WriteIdentifier(rowAlias)
+	out.WriteByte('.')
+	out.WriteIdentifier(e.column.Name())
+}
+
+// SerializeConflictUpdate serializes conflict update clause, inside which Excluded column references are allowed.
+// If rowAlias is not empty, Excluded columns are referenced through the row alias of the inserted rows.
+func SerializeConflictUpdate(clause Serializer, rowAlias string, statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.conflictUpdate = true
+	out.conflictRowAlias = rowAlias
+	defer func() {
+		out.conflictUpdate = false
+		out.conflictRowAlias = ""
+	}()
+
+	clause.serialize(statement, out, options...)
+}
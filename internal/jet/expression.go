@@ -1,6 +1,9 @@
 package jet
 
-import "fmt"
+import (
+	"fmt"
+	"github.com/go-jet/jet/v2/internal/utils"
+)
 
 // Expression is common interface for all expressions.
 // Can be Bool, Int, Float, String, Date, Time, Timez, Timestamp or Timestampz expressions.
@@ -19,6 +22,12 @@ type Expression interface {
 	IN(expressions ...Expression) BoolExpression
 	// NOT_IN checks if this expressions is different of all expressions in expressions list
 	NOT_IN(expressions ...Expression) BoolExpression
+	// IN_SELECT checks if this expression matches any value returned by sub-query.
+	// Sub-query has to project exactly one column of the same type as this expression.
+	IN_SELECT(subQuery SubQueryExpression) BoolExpression
+	// NOT_IN_SELECT checks if this expression is different of all values returned by sub-query.
+	// Sub-query has to project exactly one column of the same type as this expression.
+	NOT_IN_SELECT(subQuery SubQueryExpression) BoolExpression
 
 	// AS the temporary alias name to assign to the expression
 	AS(alias string) Projection
@@ -59,6 +68,18 @@ func (e *ExpressionInterfaceImpl) NOT_IN(expressions ...Expression) BoolExpressi
 	return newBinaryBoolOperatorExpression(e.Parent, WRAP(expressions...), "NOT IN")
 }
 
+// IN_SELECT checks if this expression matches any value returned by sub-query
+func (e *ExpressionInterfaceImpl) IN_SELECT(subQuery SubQueryExpression) BoolExpression {
+	mustProjectSingleColumnOfType(e.Parent, subQuery, "IN_SELECT")
+	return newBinaryBoolOperatorExpression(e.Parent, subQuery, "IN")
+}
+
+// NOT_IN_SELECT checks if this expression is different of all values returned by sub-query
+func (e *ExpressionInterfaceImpl) NOT_IN_SELECT(subQuery SubQueryExpression) BoolExpression {
+	mustProjectSingleColumnOfType(e.Parent, subQuery, "NOT_IN_SELECT")
+	return newBinaryBoolOperatorExpression(e.Parent, subQuery, "NOT IN")
+}
+
 // AS the temporary alias name to assign to the expression
 func (e *ExpressionInterfaceImpl) AS(alias string) Projection {
 	return newAlias(e.Parent, alias)
@@ -74,6 +95,16 @@ func (e *ExpressionInterfaceImpl) DESC() OrderByClause {
 	return newOrderByClause(e.Parent, false)
 }
 
+// NULLS_FIRST expression will be used to sort query result in ascending order, with null values appearing first
+func (e *ExpressionInterfaceImpl) NULLS_FIRST() OrderByClause {
+	return e.ASC().NULLS_FIRST()
+}
+
+// NULLS_LAST expression will be used to sort query result in ascending order, with null values appearing last
+func (e *ExpressionInterfaceImpl) NULLS_LAST() OrderByClause {
+	return e.ASC().NULLS_LAST()
+}
+
 func (e *ExpressionInterfaceImpl) serializeForGroupBy(statement StatementType, out *SQLBuilder) {
 	e.Parent.serialize(statement, out, NoWrap)
 }
@@ -113,6 +144,17 @@ func NewBinaryOperatorExpression(lhs, rhs Serializer, operator string, additiona
 }
 
 func (c *binaryOperatorExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if out.rewriteNullComparisons && (c.operator == "=" || c.operator == "!=") {
+		if isNullExpression(c.rhs) {
+			c.serializeNullComparison(c.lhs, statement, out, options...)
+			return
+		}
+		if isNullExpression(c.lhs) {
+			c.serializeNullComparison(c.rhs, statement, out, options...)
+			return
+		}
+	}
+
 	if serializeOverride := out.Dialect.OperatorSerializeOverride(c.operator); serializeOverride != nil {
 		serializeOverrideFunc := serializeOverride(c.lhs, c.rhs, c.additionalParam)
 		serializeOverrideFunc(statement, out, FallTrough(options)...)
@@ -121,6 +163,38 @@ func (c *binaryOperatorExpression) serialize(statement StatementType, out *SQLBu
 		out.WriteString(c.operator)
 		c.rhs.serialize(statement, out, FallTrough(options)...)
 	}
+
+	out.parameterName = ""
+}
+
+func (c *binaryOperatorExpression) serializeNullComparison(expression Serializer, statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	expression.serialize(statement, out, FallTrough(options)...)
+
+	if c.operator == "=" {
+		out.WriteString("IS NULL")
+	} else {
+		out.WriteString("IS NOT NULL")
+	}
+}
+
+// isNullExpression returns true for NULL and for literals with nil value, for instance nil pointer literals.
+// Typed expression wrappers(for instance StringExp(NULL)) are unwrapped.
+func isNullExpression(serializer Serializer) bool {
+	switch expression := serializer.(type) {
+	case *nullLiteral:
+		return true
+	case interface{ Value() interface{} }:
+		return utils.IsNil(expression.Value())
+	case expressionWrapper:
+		return isNullExpression(expression.unwrap())
+	}
+
+	return false
+}
+
+// expressionWrapper is implemented by typed expression wrappers, for instance StringExp or IntExp
+type expressionWrapper interface {
+	unwrap() Serializer
 }
 
 type expressionListOperator struct {
@@ -142,12 +216,44 @@ func newExpressionListOperator(operator string, expressions ...Expression) *expr
 }
 
 func newBoolExpressionListOperator(operator string, expressions ...BoolExpression) BoolExpression {
-	return BoolExp(newExpressionListOperator(operator, BoolExpressionListToExpressionList(expressions)...))
+	var nonEmptyExpressions []BoolExpression
+
+	for _, expression := range expressions {
+		if isEmptyBoolExpression(expression) {
+			continue
+		}
+		nonEmptyExpressions = append(nonEmptyExpressions, expression)
+	}
+
+	return BoolExp(newExpressionListOperator(operator, BoolExpressionListToExpressionList(nonEmptyExpressions)...))
+}
+
+// isEmptyBoolExpression returns true for nil expression and for AND/OR group without expressions
+func isEmptyBoolExpression(expression BoolExpression) bool {
+	if expression == nil {
+		return true
+	}
+
+	boolExpressionWrap, ok := expression.(*boolExpressionWrapper)
+
+	if !ok {
+		return false
+	}
+
+	expressionList, ok := boolExpressionWrap.Expression.(*expressionListOperator)
+
+	return ok && len(expressionList.expressions) == 0
 }
 
 func (elo *expressionListOperator) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
 	if len(elo.expressions) == 0 {
-		panic("jet: syntax error, expression list empty")
+		// empty AND group is always true, and empty OR group is always false
+		if elo.operator == "AND" {
+			out.WriteString("TRUE")
+		} else {
+			out.WriteString("FALSE")
+		}
+		return
 	}
 
 	shouldWrap := len(elo.expressions) > 1
@@ -227,6 +333,7 @@ func newPostfixOperatorExpression(expression Expression, operator string) *postf
 func (p *postfixOpExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
 	p.expression.serialize(statement, out, FallTrough(options)...)
 	out.WriteString(p.operator)
+	out.parameterName = ""
 }
 
 type betweenOperatorExpression struct {
@@ -261,6 +368,7 @@ func (p *betweenOperatorExpression) serialize(statement StatementType, out *SQLB
 	p.min.serialize(statement, out, FallTrough(options)...)
 	out.WriteString("AND")
 	p.max.serialize(statement, out, FallTrough(options)...)
+	out.parameterName = ""
 }
 
 type complexExpression struct {
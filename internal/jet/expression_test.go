@@ -1,6 +1,7 @@
 package jet
 
 import (
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
@@ -14,6 +15,15 @@ func TestExpressionIS_NOT_NULL(t *testing.T) {
 	assertClauseSerialize(t, table2Col3.ADD(table2Col3).IS_NOT_NULL(), "(table2.col3 + table2.col3) IS NOT NULL")
 }
 
+func TestIsNullExpression(t *testing.T) {
+	require.True(t, isNullExpression(NULL))
+	require.True(t, isNullExpression(StringExp(NULL)))
+	require.True(t, isNullExpression(IntExp(BoolExp(NULL))))
+	require.True(t, isNullExpression(TimestampExp(NULL)))
+	require.False(t, isNullExpression(StringExp(table2ColStr)))
+	require.False(t, isNullExpression(table2ColStr))
+}
+
 func TestExpressionIS_DISTINCT_FROM(t *testing.T) {
 	assertClauseSerialize(t, table2Col3.IS_DISTINCT_FROM(table2Col4), "(table2.col3 IS DISTINCT FROM table2.col4)")
 	assertClauseSerialize(t, table2Col3.ADD(table2Col3).IS_DISTINCT_FROM(Int(23)), "((table2.col3 + table2.col3) IS DISTINCT FROM $1)", int64(23))
@@ -107,6 +107,10 @@ func newFloatExpressionWrap(expression Expression) FloatExpression {
 	return &floatExpressionWrap
 }
 
+func (f *floatExpressionWrapper) unwrap() Serializer {
+	return f.Expression
+}
+
 // FloatExp is date expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as float expression.
 // Does not add sql cast to generated sql builder output.
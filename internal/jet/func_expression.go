@@ -2,12 +2,17 @@ package jet
 
 // AND function adds AND operator between expressions. This function can be used, instead of method AND,
 // to have a better inlining of a complex condition in the Go code and in the generated SQL.
+// AND and OR groups can be nested, for instance OR(AND(a, b), AND(c, d)), and each group is parenthesized.
+// Nil expressions and empty groups are skipped, so OR(AND(a, b), AND()) is the same as OR(AND(a, b)).
+// WHERE and HAVING clauses with empty group condition are omitted, and empty group used as an operand of other
+// expression is serialized as TRUE for AND group and FALSE for OR group.
 func AND(expressions ...BoolExpression) BoolExpression {
 	return newBoolExpressionListOperator("AND", expressions...)
 }
 
 // OR function adds OR operator between expressions. This function can be used, instead of method OR,
 // to have a better inlining of a complex condition in the Go code and in the generated SQL.
+// Nil expressions and empty groups are skipped.
 func OR(expressions ...BoolExpression) BoolExpression {
 	return newBoolExpressionListOperator("OR", expressions...)
 }
@@ -17,6 +22,37 @@ func ROW(expressions ...Expression) Expression {
 	return NewFunc("ROW", expressions, nil)
 }
 
+// ARRAY constructs array from the list of elements: ARRAY[element1, element2, ...]
+func ARRAY(elements ...Expression) Expression {
+	arrayConstructor := &arrayConstructor{elements: parameters(elements)}
+	arrayConstructor.ExpressionInterfaceImpl.Parent = arrayConstructor
+
+	return arrayConstructor
+}
+
+// ARRAY_SUBQUERY constructs array from the results of single column subQuery: ARRAY (SELECT ...)
+func ARRAY_SUBQUERY(subQuery Expression) Expression {
+	arraySubQuery := &prefixExpression{
+		expression: subQuery,
+		operator:   "ARRAY",
+	}
+	arraySubQuery.ExpressionInterfaceImpl.Parent = arraySubQuery
+
+	return arraySubQuery
+}
+
+type arrayConstructor struct {
+	ExpressionInterfaceImpl
+
+	elements []Expression
+}
+
+func (a *arrayConstructor) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.WriteString("ARRAY[")
+	serializeExpressionList(statement, a.elements, ", ", out)
+	out.WriteString("]")
+}
+
 // ------------------ Mathematical functions ---------------//
 
 // ABSf calculates absolute value from float expression
@@ -122,6 +158,11 @@ func COUNT(expression Expression) integerWindowExpression {
 	return newIntegerWindowFunc("COUNT", expression)
 }
 
+// COUNT_STAR is aggregate function. Returns number of all input rows, COUNT(*).
+func COUNT_STAR() integerWindowExpression {
+	return newIntegerWindowFunc("COUNT", STAR)
+}
+
 // EVERY is aggregate function. Returns true if all input values are true, otherwise false
 func EVERY(boolExpression BoolExpression) boolWindowExpression {
 	return newBoolWindowFunc("EVERY", boolExpression)
@@ -564,6 +605,33 @@ func NOW() TimestampzExpression {
 	return newTimestampzFunc("NOW")
 }
 
+//----------------- Session Information Functions ---------------//
+
+// CURRENT_USER returns user name of current execution context
+func CURRENT_USER() StringExpression {
+	return newSessionInfoFunc("CURRENT_USER")
+}
+
+// SESSION_USER returns session user name
+func SESSION_USER() StringExpression {
+	return newSessionInfoFunc("SESSION_USER")
+}
+
+// CURRENT_SCHEMA returns name of the schema first in the search path
+func CURRENT_SCHEMA() StringExpression {
+	return newSessionInfoFunc("CURRENT_SCHEMA")
+}
+
+func newSessionInfoFunc(name string) StringExpression {
+	stringFunc := &stringFunc{}
+
+	stringFunc.funcExpressionImpl = *NewFunc(name, nil, stringFunc)
+	stringFunc.stringInterfaceImpl.parent = stringFunc
+	stringFunc.noBrackets = true
+
+	return stringFunc
+}
+
 // --------------- Conditional Expressions Functions -------------//
 
 // COALESCE function returns the first of its arguments that is not null.
@@ -5,7 +5,7 @@ import (
 )
 
 func TestAND(t *testing.T) {
-	assertClauseSerializeErr(t, AND(), "jet: syntax error, expression list empty")
+	assertClauseSerialize(t, AND(), `TRUE`)
 	assertClauseSerialize(t, AND(table1ColInt.IS_NULL()), `table1.col_int IS NULL`) // IS NULL doesn't add parenthesis
 	assertClauseSerialize(t, AND(table1ColInt.LT(Int(11))), `(table1.col_int < $1)`, int64(11))
 	assertClauseSerialize(t, AND(table1ColInt.GT(Int(11)), table1ColFloat.EQ(Float(0))),
@@ -16,7 +16,7 @@ func TestAND(t *testing.T) {
 }
 
 func TestOR(t *testing.T) {
-	assertClauseSerializeErr(t, OR(), "jet: syntax error, expression list empty")
+	assertClauseSerialize(t, OR(), `FALSE`)
 	assertClauseSerialize(t, OR(table1ColInt.IS_NULL()), `table1.col_int IS NULL`) // IS NULL doesn't add parenthesis
 	assertClauseSerialize(t, OR(table1ColInt.LT(Int(11))), `(table1.col_int < $1)`, int64(11))
 	assertClauseSerialize(t, OR(table1ColInt.GT(Int(11)), table1ColFloat.EQ(Float(0))),
@@ -26,6 +26,30 @@ func TestOR(t *testing.T) {
 )`, int64(11), 0.0)
 }
 
+func TestANDORGroups(t *testing.T) {
+	assertClauseSerialize(t, OR(
+		AND(table1ColInt.GT(Int(11)), table1ColFloat.EQ(Float(0))),
+		AND(table1ColInt.LT(Int(2)), table1ColBool.IS_TRUE()),
+	), `(
+    (
+           (table1.col_int > $1)
+               AND (table1.col_float = $2)
+       )
+        OR (
+               (table1.col_int < $3)
+                   AND table1.col_bool IS TRUE
+           )
+)`, int64(11), 0.0, int64(2))
+
+	var noCondition BoolExpression
+
+	assertClauseSerialize(t, OR(AND(table1ColInt.GT(Int(11)), noCondition), AND(), OR(AND())),
+		`(table1.col_int > $1)`, int64(11))
+	assertClauseSerialize(t, AND(nil, table1ColBool.IS_TRUE()), `table1.col_bool IS TRUE`)
+	assertClauseSerialize(t, OR(AND(), noCondition), `FALSE`)
+	assertClauseSerialize(t, table1ColBool.IS_TRUE().AND(OR(AND(), OR())), `(table1.col_bool IS TRUE AND FALSE)`)
+}
+
 func TestFuncAVG(t *testing.T) {
 	assertClauseSerialize(t, AVG(table1ColFloat), "AVG(table1.col_float)")
 	assertClauseSerialize(t, AVG(table1ColInt), "AVG(table1.col_int)")
@@ -105,6 +129,7 @@ func TestFuncCOUNT(t *testing.T) {
 	assertClauseSerialize(t, COUNT(STAR), "COUNT(*)")
 	assertClauseSerialize(t, COUNT(table1ColFloat), "COUNT(table1.col_float)")
 	assertClauseSerialize(t, COUNT(Float(11.2222)), "COUNT($1)", float64(11.2222))
+	assertClauseSerialize(t, COUNT_STAR(), "COUNT(*)")
 }
 
 func TestFuncABS(t *testing.T) {
@@ -202,3 +227,20 @@ func TestTO_ASCII(t *testing.T) {
 func TestFunc(t *testing.T) {
 	assertClauseSerialize(t, Func("FOO", String("test"), NULL, MAX(Int(1))), "FOO($1, NULL, MAX($2))", "test", int64(1))
 }
+
+func TestARRAY_AGG(t *testing.T) {
+	assertClauseSerialize(t, ARRAY_AGG(table1ColInt), "ARRAY_AGG(table1.col_int)")
+	assertClauseSerialize(t, ARRAY_AGG(table1ColInt).DISTINCT(), "ARRAY_AGG(DISTINCT table1.col_int)")
+	assertClauseSerialize(t, ARRAY_AGG(table1ColInt).ORDER_BY(table1ColFloat.DESC()),
+		"ARRAY_AGG(table1.col_int ORDER BY table1.col_float DESC)")
+	assertClauseSerialize(t, ARRAY_AGG(table1ColInt).DISTINCT().ORDER_BY(table1ColInt),
+		"ARRAY_AGG(DISTINCT table1.col_int ORDER BY table1.col_int)")
+}
+
+func TestSTRING_AGG(t *testing.T) {
+	assertClauseSerialize(t, STRING_AGG(table3StrCol, String(",")), "STRING_AGG(table3.col2, $1)", ",")
+	assertClauseSerialize(t, STRING_AGG(table3StrCol, String(",")).DISTINCT().ORDER_BY(table3StrCol.ASC()),
+		"STRING_AGG(DISTINCT table3.col2, $1 ORDER BY table3.col2 ASC)", ",")
+	assertClauseSerialize(t, STRING_AGG(table3StrCol, String(",")).ORDER_BY(table3StrCol, table1ColInt.DESC()).CONCAT(String("!")),
+		"(STRING_AGG(table3.col2, $1 ORDER BY table3.col2, table1.col_int DESC) || $2)", ",", "!")
+}
@@ -145,6 +145,10 @@ func newIntExpressionWrap(expression Expression) IntegerExpression {
 	return &intExpressionWrap
 }
 
+func (i *integerExpressionWrapper) unwrap() Serializer {
+	return i.Expression
+}
+
 // IntExp is int expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as int expression.
 // Does not add sql cast to generated sql builder output.
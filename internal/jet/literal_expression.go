@@ -118,6 +118,11 @@ func Uint64(value uint64) IntegerExpression {
 	return intLiteral(value)
 }
 
+// IntPtr creates a new 64 bit signed integer literal from pointer. Nil pointer is bound as NULL.
+func IntPtr(value *int64) IntegerExpression {
+	return intLiteral(value)
+}
+
 //---------------------------------------------------//
 type boolLiteralExpression struct {
 	boolInterfaceImpl
@@ -134,6 +139,16 @@ func Bool(value bool) BoolExpression {
 	return &boolLiteralExpression
 }
 
+// BoolPtr creates new bool literal expression from pointer. Nil pointer is bound as NULL.
+func BoolPtr(value *bool) BoolExpression {
+	boolLiteralExpression := boolLiteralExpression{}
+
+	boolLiteralExpression.literalExpressionImpl = *literal(value)
+	boolLiteralExpression.boolInterfaceImpl.parent = &boolLiteralExpression
+
+	return &boolLiteralExpression
+}
+
 //---------------------------------------------------//
 type floatLiteral struct {
 	floatInterfaceImpl
@@ -150,6 +165,16 @@ func Float(value float64) FloatExpression {
 	return &floatLiteral
 }
 
+// FloatPtr creates new float literal from float64 pointer. Nil pointer is bound as NULL.
+func FloatPtr(value *float64) FloatExpression {
+	floatLiteral := floatLiteral{}
+	floatLiteral.literalExpressionImpl = *literal(value)
+
+	floatLiteral.floatInterfaceImpl.parent = &floatLiteral
+
+	return &floatLiteral
+}
+
 // Decimal creates new float literal from string value
 func Decimal(value string) FloatExpression {
 	floatLiteral := floatLiteral{}
@@ -176,6 +201,16 @@ func String(value string) StringExpression {
 	return &stringLiteral
 }
 
+// StringPtr creates new string literal expression from pointer. Nil pointer is bound as NULL.
+func StringPtr(value *string) StringExpression {
+	stringLiteral := stringLiteral{}
+	stringLiteral.literalExpressionImpl = *literal(value)
+
+	stringLiteral.stringInterfaceImpl.parent = &stringLiteral
+
+	return &stringLiteral
+}
+
 //---------------------------------------------------//
 
 type timeLiteral struct {
@@ -329,7 +364,7 @@ func formatNanoseconds(nanoseconds ...time.Duration) string {
 //--------------------------------------------------//
 
 var (
-	// NULL is jet equivalent of SQL NULL
+	// NULL is jet equivalent of SQL NULL. It can be typed using CAST when needed, for instance CAST(NULL).AS_INTEGER()
 	NULL = newNullLiteral()
 	// STAR is jet equivalent of SQL *
 	STAR = newStarLiteral()
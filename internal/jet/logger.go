@@ -39,6 +39,8 @@ type QueryInfo struct {
 	RowsProcessed int64
 	Duration      time.Duration
 	Err           error
+	// Labels attached to the statement with WithLabels method
+	Labels map[string]string
 }
 
 // QueryLoggerFunc is a function user can implement to retrieve more information about statement executed.
@@ -5,6 +5,7 @@ const (
 	StringConcatOperator        = "||"
 	StringRegexpLikeOperator    = "REGEXP"
 	StringNotRegexpLikeOperator = "NOT REGEXP"
+	AtTimeZoneOperator          = "AT TIME ZONE"
 )
 
 //----------- Logical operators ---------------//
@@ -1,13 +1,44 @@
 package jet
 
+import (
+	"fmt"
+	"reflect"
+)
+
 // OrderByClause interface
 type OrderByClause interface {
+	// NULLS_FIRST specifies sort where null values appear before all non-null values
+	NULLS_FIRST() OrderByClause
+	// NULLS_LAST specifies sort where null values appear after all non-null values
+	NULLS_LAST() OrderByClause
+
 	serializeForOrderBy(statement StatementType, out *SQLBuilder)
 }
 
+// Null ordering options
+const (
+	nullsFirst = "NULLS FIRST"
+	nullsLast  = "NULLS LAST"
+)
+
 type orderByClauseImpl struct {
-	expression Expression
-	ascent     bool
+	expression    Expression
+	ascent        bool
+	nullsOrdering string
+}
+
+func (o *orderByClauseImpl) NULLS_FIRST() OrderByClause {
+	return o.withNullsOrdering(nullsFirst)
+}
+
+func (o *orderByClauseImpl) NULLS_LAST() OrderByClause {
+	return o.withNullsOrdering(nullsLast)
+}
+
+func (o *orderByClauseImpl) withNullsOrdering(nullsOrdering string) OrderByClause {
+	newOrderBy := *o
+	newOrderBy.nullsOrdering = nullsOrdering
+	return &newOrderBy
 }
 
 func (o *orderByClauseImpl) serializeForOrderBy(statement StatementType, out *SQLBuilder) {
@@ -15,6 +46,24 @@ func (o *orderByClauseImpl) serializeForOrderBy(statement StatementType, out *SQ
 		panic("jet: nil expression in ORDER BY clause")
 	}
 
+	if o.nullsOrdering != "" {
+		// dialects without NULLS FIRST/LAST support emulate null ordering with additional sort key
+		if serializeOverride := out.Dialect.OperatorSerializeOverride(o.nullsOrdering); serializeOverride != nil {
+			serializeOverride(o.expression)(statement, out)
+			out.WriteString(", ")
+			o.serializeExpressionOrder(statement, out)
+			return
+		}
+	}
+
+	o.serializeExpressionOrder(statement, out)
+
+	if o.nullsOrdering != "" {
+		out.WriteString(o.nullsOrdering)
+	}
+}
+
+func (o *orderByClauseImpl) serializeExpressionOrder(statement StatementType, out *SQLBuilder) {
 	o.expression.serializeForOrderBy(statement, out)
 
 	if o.ascent {
@@ -24,6 +73,173 @@ func (o *orderByClauseImpl) serializeForOrderBy(statement StatementType, out *SQ
 	}
 }
 
+// orderByAggregateAlias returns order by clause referencing select clause alias, if order by clause orders by
+// aggregate expression aliased in select clause projections. Otherwise, order by clause is returned unchanged.
+func orderByAggregateAlias(statement StatementType, dialect Dialect, orderBy OrderByClause, projections []Projection) OrderByClause {
+	orderByImpl, isOrderByImpl := orderBy.(*orderByClauseImpl)
+	orderByExpression, isExpression := orderBy.(Expression)
+
+	if isOrderByImpl {
+		orderByExpression = orderByImpl.expression
+	} else if !isExpression {
+		return orderBy
+	}
+
+	for _, projection := range flattenProjections(projections) {
+		aliasProjection, ok := projection.(*alias)
+
+		if !ok || !sameExpression(aliasProjection.expression, orderByExpression) {
+			continue
+		}
+
+		scan := scanSerialization(dialect, func(out *SQLBuilder) {
+			aliasProjection.expression.serializeForGroupBy(statement, out)
+		})
+
+		if !scan.aggregate {
+			return orderBy
+		}
+
+		reference := newAliasReference(aliasProjection.alias)
+
+		if !isOrderByImpl {
+			return reference
+		}
+
+		aliasOrderBy := *orderByImpl
+		aliasOrderBy.expression = reference
+		return &aliasOrderBy
+	}
+
+	return orderBy
+}
+
+// sameExpression returns true if a and b are the same expression instance
+func sameExpression(a, b Expression) bool {
+	if a == nil || b == nil || !reflect.TypeOf(a).Comparable() || !reflect.TypeOf(b).Comparable() {
+		return false
+	}
+
+	return a == b
+}
+
+// aliasReference is expression referencing select clause projection by alias
+type aliasReference struct {
+	ExpressionInterfaceImpl
+
+	alias string
+}
+
+func newAliasReference(alias string) Expression {
+	ret := &aliasReference{alias: alias}
+	ret.ExpressionInterfaceImpl.Parent = ret
+	return ret
+}
+
+func (a *aliasReference) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.WriteAlias(a.alias)
+}
+
+// MustMatchDistinctOn panics if DISTINCT ON columns do not match the leftmost ORDER BY expressions.
+// ORDER BY list without expressions other than DISTINCT ON columns is always valid.
+func MustMatchDistinctOn(distinctOnColumns []ColumnExpression, orderBy []OrderByClause) {
+	if len(orderBy) == 0 {
+		return
+	}
+
+	isDistinctOn := func(column Column) bool {
+		for _, distinctOnColumn := range distinctOnColumns {
+			if sameColumn(column, distinctOnColumn) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var leftmostColumns []Column
+
+	for i, orderByClause := range orderBy {
+		column := orderByColumn(orderByClause)
+
+		if column == nil || !isDistinctOn(column) {
+			break
+		}
+
+		leftmostColumns = append(leftmostColumns, column)
+
+		if i == len(orderBy)-1 {
+			return
+		}
+	}
+
+	for _, distinctOnColumn := range distinctOnColumns {
+		found := false
+
+		for _, column := range leftmostColumns {
+			if sameColumn(column, distinctOnColumn) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			panic(fmt.Sprintf("jet: DISTINCT ON column '%s' has to match one of the leftmost ORDER BY expressions, "+
+				"ORDER BY has to start with DISTINCT ON columns", columnFullName(distinctOnColumn)))
+		}
+	}
+}
+
+func sameColumn(lhs, rhs Column) bool {
+	return lhs.TableName() == rhs.TableName() && lhs.Name() == rhs.Name()
+}
+
+func columnFullName(column Column) string {
+	if column.TableName() == "" {
+		return column.Name()
+	}
+
+	return column.TableName() + "." + column.Name()
+}
+
+// orderByColumn returns column clause is ordering by, or nil if clause is not ordering by a column
+func orderByColumn(orderByClause OrderByClause) Column {
+	switch clause := orderByClause.(type) {
+	case *orderByClauseImpl:
+		column, _ := clause.expression.(Column)
+		return column
+	case Column:
+		return clause
+	}
+
+	return nil
+}
+
 func newOrderByClause(expression Expression, ascent bool) OrderByClause {
 	return &orderByClauseImpl{expression: expression, ascent: ascent}
 }
+
+// NormalizeNullOrdering sets explicit null ordering to all the order by clauses without one, so that the order of
+// the result does not depend on database defaults. NULL values are sorted as in PostgreSQL: last in ascending,
+// and first in descending order.
+func NormalizeNullOrdering(orderByClauses ...OrderByClause) []OrderByClause {
+	var ret []OrderByClause
+
+	for _, orderByClause := range orderByClauses {
+		switch clause := orderByClause.(type) {
+		case nil:
+			ret = append(ret, clause)
+		case *orderByClauseImpl:
+			if clause.nullsOrdering != "" {
+				ret = append(ret, clause)
+			} else if clause.ascent {
+				ret = append(ret, clause.NULLS_LAST())
+			} else {
+				ret = append(ret, clause.NULLS_FIRST())
+			}
+		default:
+			ret = append(ret, clause.NULLS_LAST())
+		}
+	}
+
+	return ret
+}
@@ -81,3 +81,23 @@ func (pl ProjectionList) Except(toExclude ...Column) ProjectionList {
 
 	return ret
 }
+
+// Add will create new projection list with projections appended to the end of the list
+func (pl ProjectionList) Add(projections ...Projection) ProjectionList {
+	ret := make(ProjectionList, 0, len(pl)+len(projections))
+	ret = append(ret, pl...)
+
+	return append(ret, projections...)
+}
+
+// AddIf will create new projection list with projections appended to the end of the list, only if condition is true.
+// For instance, expensive column can be included in SELECT clause only when it is requested:
+// ProjectionList{Film.FilmID, Film.Title}.AddIf(withDescription, Film.Description)
+// Query result mapping maps only the columns present in the query, other destination fields are left unchanged.
+func (pl ProjectionList) AddIf(condition bool, projections ...Projection) ProjectionList {
+	if !condition {
+		return pl.Add()
+	}
+
+	return pl.Add(projections...)
+}
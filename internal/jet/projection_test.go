@@ -44,3 +44,20 @@ AVG(table1.col_int) AS "new_alias.avg"`)
 "subQuery".avg AS "subAlias.avg",
 "subQuery"."t.avg" AS "subAlias.avg"`)
 }
+
+func TestProjectionAddIf(t *testing.T) {
+	projectionList := ProjectionList{table1Col1}
+
+	assertProjectionSerialize(t, projectionList.AddIf(true, table1ColInt, SUM(table1ColFloat).AS("sum")),
+		`table1.col1 AS "table1.col1",
+table1.col_int AS "table1.col_int",
+SUM(table1.col_float) AS "sum"`)
+	assertProjectionSerialize(t, projectionList.AddIf(false, table1ColInt), `table1.col1 AS "table1.col1"`)
+	assertProjectionSerialize(t, projectionList.Add(table1ColBool).AddIf(false, table1ColInt).AddIf(true, table1Col3),
+		`table1.col1 AS "table1.col1",
+table1.col_bool AS "table1.col_bool",
+table1.col3 AS "table1.col3"`)
+
+	// original list is not modified
+	assertProjectionSerialize(t, projectionList, `table1.col1 AS "table1.col1"`)
+}
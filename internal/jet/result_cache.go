@@ -0,0 +1,246 @@
+package jet
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-jet/jet/v2/internal/utils"
+	"github.com/go-jet/jet/v2/qrm"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ResultCache is a wrapper around database connection/transaction, which caches mapped query results of statements
+// for a ttl duration. Results are cached by statement dialect, sql query, argument values and types, and destination
+// type. It is intended for rarely changing data, for instance reference data tables.
+// Each query receives a deep copy of the cached result, so destination can be freely modified.
+// Expired results are removed when new result is cached.
+type ResultCache struct {
+	db  qrm.DB
+	ttl time.Duration
+	now func() time.Time
+
+	mutex   sync.Mutex
+	entries map[resultCacheKey]resultCacheEntry
+}
+
+type resultCacheKey struct {
+	destinationType reflect.Type
+	statement       string // dialect name, sql query, argument types and values
+}
+
+type resultCacheEntry struct {
+	result  reflect.Value
+	expires time.Time
+}
+
+// NewResultCache creates new ResultCache around db, whose cached results expire after ttl duration
+func NewResultCache(db qrm.DB, ttl time.Duration) *ResultCache {
+	return &ResultCache{
+		db:      db,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[resultCacheKey]resultCacheEntry{},
+	}
+}
+
+// Query executes statement and stores mapped result in destination, the same as Statement.QueryContext.
+// If the same statement result has already been cached and not expired, statement is not executed, and destination
+// is set to cached result instead. Query errors are not cached.
+func (c *ResultCache) Query(ctx context.Context, statement Statement, destination interface{}) error {
+	utils.MustBeInitializedPtr(destination, "jet: destination is nil")
+	utils.MustBe(destination, reflect.Ptr, "jet: destination has to be a pointer to slice or pointer to struct")
+
+	destinationValue := reflect.ValueOf(destination).Elem()
+	key, err := newResultCacheKey(ctx, statement, destinationValue.Type())
+
+	if err != nil {
+		return err
+	}
+
+	if result, ok := c.get(key); ok {
+		destinationValue.Set(copyResult(result))
+		return nil
+	}
+
+	result := reflect.New(destinationValue.Type())
+
+	if err := statement.QueryContext(ctx, c.db, result.Interface()); err != nil {
+		return err
+	}
+
+	c.set(key, result.Elem())
+	destinationValue.Set(copyResult(result.Elem()))
+
+	return nil
+}
+
+// Clear removes all the cached results
+func (c *ResultCache) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.entries = map[resultCacheKey]resultCacheEntry{}
+}
+
+func (c *ResultCache) get(key resultCacheKey) (reflect.Value, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[key]
+
+	if !ok {
+		return reflect.Value{}, false
+	}
+
+	if !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		return reflect.Value{}, false
+	}
+
+	return entry.result, true
+}
+
+func (c *ResultCache) set(key resultCacheKey, result reflect.Value) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := c.now()
+
+	for entryKey, entry := range c.entries { // sweep expired entries, so that cache does not grow with stale results
+		if !now.Before(entry.expires) {
+			delete(c.entries, entryKey)
+		}
+	}
+
+	c.entries[key] = resultCacheEntry{
+		result:  result,
+		expires: now.Add(c.ttl),
+	}
+}
+
+// copyResult returns deep copy of cached result, so that modifying destination, or any slice, map or struct referenced
+// from destination, does not modify cached result
+func copyResult(result reflect.Value) reflect.Value {
+	switch result.Kind() {
+	case reflect.Ptr:
+		if result.IsNil() {
+			return result
+		}
+
+		resultCopy := reflect.New(result.Type().Elem())
+		resultCopy.Elem().Set(copyResult(result.Elem()))
+
+		return resultCopy
+
+	case reflect.Interface:
+		if result.IsNil() {
+			return result
+		}
+
+		resultCopy := reflect.New(result.Type()).Elem()
+		resultCopy.Set(copyResult(result.Elem()))
+
+		return resultCopy
+
+	case reflect.Slice:
+		if result.IsNil() {
+			return result
+		}
+
+		resultCopy := reflect.MakeSlice(result.Type(), result.Len(), result.Len())
+
+		for i := 0; i < result.Len(); i++ {
+			resultCopy.Index(i).Set(copyResult(result.Index(i)))
+		}
+
+		return resultCopy
+
+	case reflect.Array:
+		resultCopy := reflect.New(result.Type()).Elem()
+
+		for i := 0; i < result.Len(); i++ {
+			resultCopy.Index(i).Set(copyResult(result.Index(i)))
+		}
+
+		return resultCopy
+
+	case reflect.Map:
+		if result.IsNil() {
+			return result
+		}
+
+		resultCopy := reflect.MakeMapWithSize(result.Type(), result.Len())
+
+		for _, key := range result.MapKeys() {
+			resultCopy.SetMapIndex(key, copyResult(result.MapIndex(key)))
+		}
+
+		return resultCopy
+
+	case reflect.Struct:
+		resultCopy := reflect.New(result.Type()).Elem()
+		resultCopy.Set(result)
+
+		for i := 0; i < result.NumField(); i++ {
+			if field := resultCopy.Field(i); field.CanSet() { // unexported fields, for instance of time.Time, are shallow copied
+				field.Set(copyResult(result.Field(i)))
+			}
+		}
+
+		return resultCopy
+	}
+
+	return result
+}
+
+func newResultCacheKey(ctx context.Context, statement Statement, destinationType reflect.Type) (resultCacheKey, error) {
+	query, args, err := statement.executableSqlContext(ctx)
+
+	if err != nil {
+		return resultCacheKey{}, err
+	}
+
+	key := strings.Builder{}
+	key.WriteString(statement.statementDialect().Name())
+	key.WriteString("\n")
+	key.WriteString(query)
+
+	for _, arg := range args {
+		value, err := resultCacheArgValue(arg)
+
+		if err != nil {
+			return resultCacheKey{}, err
+		}
+
+		if timeValue, ok := value.(time.Time); ok { // time location is a pointer, and would be formatted as an address
+			value = timeValue.Format(time.RFC3339Nano + " MST")
+		}
+
+		key.WriteString(fmt.Sprintf("\n%T:%#v", arg, value))
+	}
+
+	return resultCacheKey{
+		destinationType: destinationType,
+		statement:       key.String(),
+	}, nil
+}
+
+// resultCacheArgValue returns argument value as it is sent to the database(see bindValue), so that result cache key
+// does not contain pointer addresses. Pointers are dereferenced, and driver.Valuer value is used instead of valuer.
+func resultCacheArgValue(arg interface{}) (value interface{}, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			argumentErr, ok := recovered.(argumentError)
+
+			if !ok {
+				panic(recovered)
+			}
+
+			err = argumentErr
+		}
+	}()
+
+	return bindValue(arg), nil
+}
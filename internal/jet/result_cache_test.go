@@ -0,0 +1,171 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// rowsRecorderConn is fake driver connection, which records executed queries and returns rows with a single column
+type rowsRecorderConn struct {
+	txRecorderConn
+
+	column string
+	values []driver.Value
+	err    error
+}
+
+func (c *rowsRecorderConn) Open(name string) (driver.Conn, error) { return c, nil }
+func (c *rowsRecorderConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.queries = append(c.queries, query)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &singleColumnRows{column: c.column, values: c.values}, nil
+}
+
+type singleColumnRows struct {
+	column string
+	values []driver.Value
+}
+
+func (r *singleColumnRows) Columns() []string { return []string{r.column} }
+func (r *singleColumnRows) Close() error      { return nil }
+func (r *singleColumnRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0], r.values = r.values[0], r.values[1:]
+	return nil
+}
+
+func TestResultCache(t *testing.T) {
+	conn := &rowsRecorderConn{column: "table1.col1", values: []driver.Value{int64(1), int64(2)}}
+	sql.Register("jet-rows-recorder", conn)
+
+	db, err := sql.Open("jet-rows-recorder", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+	cache := NewResultCache(db, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	type Table1 struct {
+		Col1 int64
+	}
+
+	stmt := RawStatement(defaultDialect, "SELECT col1 AS \"table1.col1\" FROM table1 WHERE col1 > #1", map[string]interface{}{"#1": 0})
+
+	var dest []Table1
+	require.NoError(t, cache.Query(context.Background(), stmt, &dest))
+	require.Equal(t, []Table1{{Col1: 1}, {Col1: 2}}, dest)
+
+	// cached result is returned, modifying returned slice does not modify cached result
+	dest[0].Col1 = 100
+	var cached []Table1
+	require.NoError(t, cache.Query(context.Background(), stmt, &cached))
+	require.Equal(t, []Table1{{Col1: 1}, {Col1: 2}}, cached)
+	require.Len(t, conn.queries, 1)
+
+	// different argument type or value and different destination type are cached separately
+	require.NoError(t, cache.Query(context.Background(),
+		RawStatement(defaultDialect, "SELECT col1 AS \"table1.col1\" FROM table1 WHERE col1 > #1", map[string]interface{}{"#1": "0"}), &cached))
+	require.NoError(t, cache.Query(context.Background(),
+		RawStatement(defaultDialect, "SELECT col1 AS \"table1.col1\" FROM table1 WHERE col1 > #1", map[string]interface{}{"#1": 1}), &cached))
+	var single Table1
+	require.NoError(t, cache.Query(context.Background(), stmt, &single))
+	require.Equal(t, Table1{Col1: 1}, single)
+	require.Len(t, conn.queries, 4)
+
+	// expired result is queried again, and other expired results are removed from the cache
+	now = now.Add(time.Minute)
+	require.NoError(t, cache.Query(context.Background(), stmt, &cached))
+	require.Len(t, conn.queries, 5)
+	require.Len(t, cache.entries, 1)
+
+	cache.Clear()
+	require.NoError(t, cache.Query(context.Background(), stmt, &cached))
+	require.Len(t, conn.queries, 6)
+
+	// pointer and driver.Valuer arguments are cached by value
+	value := 1
+	pointerStmt := RawStatement(defaultDialect, "SELECT col1 AS \"table1.col1\" FROM table1 WHERE col1 > #1", map[string]interface{}{"#1": &value})
+	require.NoError(t, cache.Query(context.Background(), pointerStmt, &cached))
+	require.NoError(t, cache.Query(context.Background(), pointerStmt, &cached))
+	require.Len(t, conn.queries, 7)
+	value = 2
+	require.NoError(t, cache.Query(context.Background(), pointerStmt, &cached))
+	require.Len(t, conn.queries, 8)
+
+	valuer := sql.NullInt64{Int64: 1, Valid: true}
+	valuerStmt := RawStatement(defaultDialect, "SELECT col1 AS \"table1.col1\" FROM table1 WHERE col1 > #1", map[string]interface{}{"#1": &valuer})
+	require.NoError(t, cache.Query(context.Background(), valuerStmt, &cached))
+	require.NoError(t, cache.Query(context.Background(), valuerStmt, &cached))
+	require.Len(t, conn.queries, 9)
+	valuer.Int64 = 2
+	require.NoError(t, cache.Query(context.Background(), valuerStmt, &cached))
+	require.Len(t, conn.queries, 10)
+
+	// statement serialization errors are returned, and statement is not executed
+	require.EqualError(t, cache.Query(context.Background(),
+		RawStatement(defaultDialect, "SELECT col1 AS \"table1.col1\" FROM table1 WHERE col1 > #1", map[string]interface{}{"#1": failingValuer{}}), &cached),
+		"jet: failed to bind jet.failingValuer parameter value, value failed")
+	require.Len(t, conn.queries, 10)
+
+	// errors are not cached
+	conn.err = errors.New("query failed")
+	cache.Clear()
+	require.Error(t, cache.Query(context.Background(), stmt, &cached))
+	require.Error(t, cache.Query(context.Background(), stmt, &cached))
+	require.Len(t, conn.queries, 12)
+}
+
+type failingValuer struct{}
+
+func (failingValuer) Value() (driver.Value, error) {
+	return nil, errors.New("value failed")
+}
+
+func TestResultCacheCopyResult(t *testing.T) {
+	type Tag struct {
+		Name *string
+	}
+
+	type Model struct {
+		Tags       []Tag
+		Attributes map[string][]int
+		Parent     *Model
+		CreatedAt  time.Time
+		Any        interface{}
+	}
+
+	name := "tag"
+	cached := []Model{{
+		Tags:       []Tag{{Name: &name}},
+		Attributes: map[string][]int{"a": {1}},
+		Parent:     &Model{Tags: []Tag{}},
+		CreatedAt:  time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		Any:        []int{1},
+	}}
+
+	result := copyResult(reflect.ValueOf(cached)).Interface().([]Model)
+	require.Equal(t, cached, result)
+
+	*result[0].Tags[0].Name = "modified"
+	result[0].Attributes["a"][0] = 2
+	result[0].Attributes["b"] = nil
+	result[0].Parent.Tags = append(result[0].Parent.Tags, Tag{})
+	result[0].Any.([]int)[0] = 2
+
+	require.Equal(t, "tag", name)
+	require.Equal(t, map[string][]int{"a": {1}}, cached[0].Attributes)
+	require.Empty(t, cached[0].Parent.Tags)
+	require.Equal(t, []int{1}, cached[0].Any)
+}
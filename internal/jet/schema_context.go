@@ -0,0 +1,23 @@
+package jet
+
+import "context"
+
+type schemaContextKey struct{}
+
+// WithSchema returns a copy of ctx carrying schema name override. Statements executed with such context
+// (QueryContext, ExecContext and Rows) are serialized with schema replacing static schema name of every table.
+// Tables without schema name are left unqualified.
+func WithSchema(ctx context.Context, schema string) context.Context {
+	return context.WithValue(ctx, schemaContextKey{}, schema)
+}
+
+// SchemaFromContext returns schema name override stored in ctx by WithSchema.
+func SchemaFromContext(ctx context.Context) (schema string, ok bool) {
+	if ctx == nil {
+		return "", false
+	}
+
+	schema, ok = ctx.Value(schemaContextKey{}).(string)
+
+	return schema, ok && len(schema) > 0
+}
@@ -1,5 +1,7 @@
 package jet
 
+import "github.com/go-jet/jet/v2/internal/utils"
+
 // SelectTable is interface for SELECT sub-queries
 type SelectTable interface {
 	SerializerHasProjections
@@ -8,15 +10,23 @@ type SelectTable interface {
 }
 
 type selectTableImpl struct {
-	Statement SerializerHasProjections
-	alias     string
+	Statement     SerializerHasProjections
+	alias         string
+	columnAliases []string
 }
 
-// NewSelectTable func
-func NewSelectTable(selectStmt SerializerHasProjections, alias string) selectTableImpl {
+// NewSelectTable creates new derived table from statement with alias. Optional column aliases are serialized after
+// table alias, for instance: AS alias(column1, column2). Panics if alias is empty, because derived table columns
+// can be referenced only through alias.
+func NewSelectTable(selectStmt SerializerHasProjections, alias string, columnAliases ...string) selectTableImpl {
+	if alias == "" {
+		panic("jet: sub-query alias is empty")
+	}
+
 	selectTable := selectTableImpl{
-		Statement: selectStmt,
-		alias:     alias,
+		Statement:     selectStmt,
+		alias:         alias,
+		columnAliases: columnAliases,
 	}
 
 	return selectTable
@@ -40,6 +50,19 @@ func (s selectTableImpl) serialize(statement StatementType, out *SQLBuilder, opt
 
 	out.WriteString("AS")
 	out.WriteIdentifier(s.alias)
+	out.columnScope.addTable(s.alias)
+
+	if len(s.columnAliases) > 0 {
+		out.writeAttached("(") // column aliases list immediately follows table alias
+
+		for i, columnAlias := range s.columnAliases {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+			out.WriteIdentifier(columnAlias)
+		}
+		out.WriteByte(')')
+	}
 }
 
 // --------------------------------------
@@ -48,8 +71,17 @@ type lateralImpl struct {
 	selectTableImpl
 }
 
-// NewLateral creates new lateral expression from select statement with alias
+// NewLateral creates new lateral expression from select statement with alias. Panics if select statement is nil
+// or alias is empty, because lateral sub-query columns can be referenced only through alias.
 func NewLateral(selectStmt SerializerStatement, alias string) SelectTable {
+	if utils.IsNil(selectStmt) {
+		panic("jet: LATERAL sub-query is nil")
+	}
+
+	if alias == "" {
+		panic("jet: LATERAL sub-query alias is empty")
+	}
+
 	return lateralImpl{selectTableImpl: NewSelectTable(selectStmt, alias)}
 }
 
@@ -59,4 +91,60 @@ func (s lateralImpl) serialize(statement StatementType, out *SQLBuilder, options
 
 	out.WriteString("AS")
 	out.WriteIdentifier(s.alias)
+	out.columnScope.addTable(s.alias)
+}
+
+// --------------------------------------
+
+type functionTableImpl struct {
+	function *funcExpressionImpl
+	alias    string
+	columns  []ColumnExpression
+}
+
+// NewFunctionTable creates new table from set-returning function call with alias. Column definitions are serialized
+// after table alias, for instance: name(args...) AS alias(column1, column2). Definition columns are not modified,
+// function table columns are referenced the same way as sub-query columns, through column.From(functionTable).
+func NewFunctionTable(name string, args []Expression, alias string, columns ...ColumnExpression) SelectTable {
+	if alias == "" {
+		panic("jet: function table '" + name + "' alias is empty")
+	}
+
+	return &functionTableImpl{
+		function: NewFunc(name, args, nil),
+		alias:    alias,
+		columns:  columns,
+	}
+}
+
+func (f *functionTableImpl) projections() ProjectionList {
+	projections := make(ProjectionList, 0, len(f.columns))
+
+	for _, column := range f.columns {
+		projections = append(projections, column)
+	}
+
+	return projections
+}
+
+func (f *functionTableImpl) Alias() string {
+	return f.alias
+}
+
+func (f *functionTableImpl) AllColumns() ProjectionList {
+	return f.projections().fromImpl(f).(ProjectionList)
+}
+
+func (f *functionTableImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	f.function.serialize(statement, out)
+
+	out.WriteString("AS")
+	out.WriteIdentifier(f.alias)
+	out.columnScope.addTable(f.alias)
+
+	if len(f.columns) > 0 {
+		out.writeAttached("(") // column definitions list immediately follows table alias
+		SerializeColumnExpressionNames(f.columns, out)
+		out.WriteByte(')')
+	}
 }
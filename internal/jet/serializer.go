@@ -1,5 +1,10 @@
 package jet
 
+import (
+	"errors"
+	"github.com/go-jet/jet/v2/internal/utils"
+)
+
 // SerializeOption type
 type SerializeOption int
 
@@ -32,6 +37,9 @@ const (
 	LockStatementType   StatementType = "LOCK"
 	UnLockStatementType StatementType = "UNLOCK"
 	WithStatementType   StatementType = "WITH"
+	ValuesStatementType StatementType = "VALUES"
+	// SetConstraintsStatementType is PostgreSQL SET CONSTRAINTS statement type
+	SetConstraintsStatementType StatementType = "SET CONSTRAINTS"
 )
 
 // Serializer interface
@@ -67,6 +75,21 @@ func FallTrough(options []SerializeOption) []SerializeOption {
 	return ret
 }
 
+// subQueryOptions filters fall-trough options passed to the clauses of sub-query. ShortName is removed, because columns
+// referenced from correlated sub-query have to stay qualified with table name or alias, to avoid ambiguity with the
+// columns of sub-query tables.
+func subQueryOptions(options []SerializeOption) []SerializeOption {
+	var ret []SerializeOption
+
+	for _, option := range FallTrough(options) {
+		if option != ShortName {
+			ret = append(ret, option)
+		}
+	}
+
+	return ret
+}
+
 // ListSerializer serializes list of serializers with separator
 type ListSerializer struct {
 	Serializers []Serializer
@@ -96,3 +119,19 @@ func (s serializerImpl) serialize(statement StatementType, out *SQLBuilder, opti
 		clause.Serialize(statement, out, FallTrough(options)...)
 	}
 }
+
+// ExpressionSql serializes expression alone, independently of the surrounding statement, into parametrized sql fragment
+// and list of arguments. Placeholders are relative to the fragment, for dialects with numbered placeholders numbering
+// starts from $1. Serialization panics are returned as error.
+func ExpressionSql(dialect Dialect, expression Expression) (query string, args []interface{}, err error) {
+	defer utils.ErrorCatch(&err)
+
+	if utils.IsNil(expression) {
+		return "", nil, errors.New("jet: expression is nil")
+	}
+
+	out := &SQLBuilder{Dialect: dialect}
+	expression.serialize(SelectStatementType, out, NoWrap)
+
+	return out.Buff.String(), out.Args, nil
+}
@@ -2,6 +2,7 @@ package jet
 
 import (
 	"bytes"
+	"database/sql/driver"
 	"fmt"
 	"github.com/go-jet/jet/v2/internal/3rdparty/pq"
 	"github.com/go-jet/jet/v2/internal/utils"
@@ -24,6 +25,49 @@ type SQLBuilder struct {
 	ident    int
 
 	Debug bool
+
+	schema string
+	// cteReferences, if set, collects names of common table expressions referenced in the serialized query
+	cteReferences map[string]bool
+	// rewriteNullComparisons, if set, serializes comparisons with NULL as IS NULL and IS NOT NULL
+	rewriteNullComparisons bool
+	// identifierCase defines how schema, table and column identifiers are written
+	identifierCase IdentifierCase
+	// conflictUpdate is set while conflict update clause, in which Excluded columns are allowed, is serialized
+	conflictUpdate bool
+	// conflictRowAlias is row alias of the inserted rows, through which Excluded columns are referenced
+	conflictRowAlias string
+	// projectionScan, if set, records whether serialized expression references columns, aggregate or window functions
+	projectionScan *projectionScan
+	// deduplicateParameters, if set, reuses placeholder of already bound identical parameter value
+	deduplicateParameters bool
+	// argPositions are positions of already bound hashable parameter values, used for parameter deduplication
+	argPositions map[interface{}]int
+	// unhashableArgPositions are positions of already bound parameter values, which can not be used as map key
+	unhashableArgPositions []int
+	// namedArgs, if set, collects statement parameters as named arguments serialized with :name placeholders
+	namedArgs map[string]interface{}
+	// parameterName is name of the next named parameter, set to the name of the last serialized column. It is reset once
+	// used and at the end of each comparison, projection and clause, so arguments not related to a column are named param_N.
+	parameterName string
+	// insertColumns are columns of serialized INSERT clause, used to name parameters of VALUES rows
+	insertColumns []Column
+	// columnScope, if set, collects tables in scope and columns referenced by the serialized statement
+	columnScope *columnScope
+}
+
+type projectionScan struct {
+	column    bool
+	aggregate bool
+	window    bool
+}
+
+// scanSerialization dry-runs serialize function, to find out whether serialized expression references columns,
+// aggregate or window functions
+func scanSerialization(dialect Dialect, serialize func(out *SQLBuilder)) projectionScan {
+	out := &SQLBuilder{Dialect: dialect, projectionScan: &projectionScan{}}
+	serialize(out)
+	return *out.projectionScan
 }
 
 const tabSize = 4
@@ -80,11 +124,21 @@ func (s *SQLBuilder) write(data []byte) {
 }
 
 func isPreSeparator(b byte) bool {
-	return b == ' ' || b == '.' || b == ',' || b == '(' || b == '\n' || b == ':'
+	return b == ' ' || b == '.' || b == ',' || b == '(' || b == '[' || b == '\n' || b == ':'
 }
 
 func isPostSeparator(b byte) bool {
-	return b == ' ' || b == '.' || b == ',' || b == ')' || b == '\n' || b == ':'
+	return b == ' ' || b == '.' || b == ',' || b == ')' || b == ']' || b == '\n' || b == ':'
+}
+
+// writeAttached writes str to output SQL right after previously written text, without separating space
+func (s *SQLBuilder) writeAttached(str string) {
+	if len(str) == 0 {
+		return
+	}
+
+	s.Buff.WriteString(str)
+	s.lastChar = str[len(str)-1]
 }
 
 // WriteAlias is used to add alias to output SQL
@@ -98,8 +152,29 @@ func (s *SQLBuilder) WriteString(str string) {
 	s.write([]byte(str))
 }
 
+// IdentifierCase defines how schema, table and column identifiers are written to SQL query
+type IdentifierCase int
+
+const (
+	// IdentifierCaseDefault writes identifiers unchanged, and quotes them only if they are reserved words or contain
+	// upper case or non-ASCII characters.
+	IdentifierCaseDefault IdentifierCase = iota
+	// IdentifierCasePreserve writes identifiers unchanged and always quoted, so the database matches them case-sensitively.
+	IdentifierCasePreserve
+	// IdentifierCaseLower folds identifiers to lower case, the same way PostgreSQL folds unquoted identifiers.
+	// Lower cased identifiers are quoted only if they are reserved words or contain non-ASCII characters.
+	IdentifierCaseLower
+)
+
 // WriteIdentifier adds identifier to output SQL
 func (s *SQLBuilder) WriteIdentifier(name string, alwaysQuote ...bool) {
+	switch s.identifierCase {
+	case IdentifierCasePreserve:
+		alwaysQuote = []bool{true}
+	case IdentifierCaseLower:
+		name = strings.ToLower(name)
+	}
+
 	if s.shouldQuote(name, alwaysQuote...) {
 		identQuoteChar := string(s.Dialect.IdentifierQuoteChar())
 		s.WriteString(identQuoteChar + name + identQuoteChar)
@@ -122,7 +197,7 @@ func (s *SQLBuilder) finalize() (string, []interface{}) {
 }
 
 func (s *SQLBuilder) insertConstantArgument(arg interface{}) {
-	s.WriteString(argToString(arg))
+	s.WriteString(s.argToString(arg))
 }
 
 func (s *SQLBuilder) insertParametrizedArgument(arg interface{}) {
@@ -131,12 +206,155 @@ func (s *SQLBuilder) insertParametrizedArgument(arg interface{}) {
 		return
 	}
 
+	arg = bindParameter(arg)
+
+	if s.namedArgs != nil {
+		name := s.parameterName
+		s.parameterName = ""
+
+		if name == "" {
+			name = "param_" + strconv.Itoa(len(s.namedArgs)+1)
+		}
+
+		s.writeNamedPlaceholder(s.addNamedArgument(name, arg))
+		return
+	}
+
+	if s.deduplicateParameters && s.hasNumberedPlaceholders() {
+		if position, ok := s.boundArgPosition(arg); ok {
+			s.WriteString(s.Dialect.ArgumentPlaceholder()(position))
+			return
+		}
+	}
+
 	s.Args = append(s.Args, arg)
 	argPlaceholder := s.Dialect.ArgumentPlaceholder()(len(s.Args))
 
+	if s.deduplicateParameters {
+		s.addBoundArgPosition(arg, len(s.Args))
+	}
+
 	s.WriteString(argPlaceholder)
 }
 
+// boundArgPosition returns placeholder position of already bound parameter value equal to arg. Hashable values are
+// looked up in a map, and only unhashable values are compared with the other unhashable values one by one.
+func (s *SQLBuilder) boundArgPosition(arg interface{}) (int, bool) {
+	if isHashable(arg) {
+		position, ok := s.argPositions[arg]
+		return position, ok
+	}
+
+	for _, position := range s.unhashableArgPositions {
+		if reflect.DeepEqual(s.Args[position-1], arg) {
+			return position, true
+		}
+	}
+
+	return 0, false
+}
+
+func (s *SQLBuilder) addBoundArgPosition(arg interface{}, position int) {
+	if !isHashable(arg) {
+		s.unhashableArgPositions = append(s.unhashableArgPositions, position)
+		return
+	}
+
+	if s.argPositions == nil {
+		s.argPositions = map[interface{}]int{}
+	}
+
+	s.argPositions[arg] = position
+}
+
+// isHashable returns true if value can be used as map key. Values with interface components are not hashable, because
+// their dynamic values may not be comparable. Equal hashable values(==) are always reflect.DeepEqual as well.
+func isHashable(value interface{}) bool {
+	return value != nil && isHashableType(reflect.TypeOf(value))
+}
+
+func isHashableType(valueType reflect.Type) bool {
+	switch valueType.Kind() {
+	case reflect.Interface:
+		return false
+	case reflect.Array:
+		return isHashableType(valueType.Elem())
+	case reflect.Struct:
+		for i := 0; i < valueType.NumField(); i++ {
+			if !isHashableType(valueType.Field(i).Type) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return valueType.Comparable()
+}
+
+// hasNumberedPlaceholders returns true if dialect placeholders reference arguments by position, for instance $1, $2,
+// so the same placeholder can be used multiple times within the query.
+func (s *SQLBuilder) hasNumberedPlaceholders() bool {
+	argumentPlaceholder := s.Dialect.ArgumentPlaceholder()
+
+	return argumentPlaceholder(1) != argumentPlaceholder(2)
+}
+
+// NamedArguments returns true if statement parameters are serialized as :name placeholders
+func (s *SQLBuilder) NamedArguments() bool {
+	return s.namedArgs != nil && !s.Debug
+}
+
+// writeNamedPlaceholder writes :name placeholder. Colon is a separator character, so white space before it has to be
+// written explicitly.
+func (s *SQLBuilder) writeNamedPlaceholder(name string) {
+	if !isPreSeparator(s.lastChar) && s.Buff.Len() > 0 {
+		s.WriteByte(' ')
+	}
+
+	s.WriteString(":" + name)
+}
+
+// addNamedArgument adds named argument and returns its name. Existing name is reused if it is already bound to
+// the same value, otherwise name is disambiguated with numeric suffix, for instance: name_2, name_3.
+func (s *SQLBuilder) addNamedArgument(name string, value interface{}) string {
+	name = namedArgumentName(name)
+
+	for i := 1; ; i++ {
+		candidate := name
+
+		if i > 1 {
+			candidate = name + "_" + strconv.Itoa(i)
+		}
+
+		existing, ok := s.namedArgs[candidate]
+
+		if !ok {
+			s.namedArgs[candidate] = value
+			return candidate
+		}
+
+		if reflect.DeepEqual(existing, value) {
+			return candidate
+		}
+	}
+}
+
+// namedArgumentName converts name into valid named parameter identifier
+func namedArgumentName(name string) string {
+	identifier := strings.Trim(strings.Map(func(r rune) rune {
+		if r == '_' || (r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r))) {
+			return r
+		}
+		return '_'
+	}, name), "_")
+
+	if len(identifier) == 0 || unicode.IsDigit(rune(identifier[0])) {
+		return "param" + identifier
+	}
+
+	return identifier
+}
+
 func (s *SQLBuilder) insertRawQuery(raw string, namedArg map[string]interface{}) {
 	type namedArgumentPosition struct {
 		Name     string
@@ -184,18 +402,24 @@ func (s *SQLBuilder) insertRawQuery(raw string, namedArg map[string]interface{})
 		if !strings.Contains(raw, namedArgumentPos.Name) {
 			continue
 		}
-		s.Args = append(s.Args, namedArgumentPos.Value)
-		currentArgNum := len(s.Args)
-
-		placeholder := s.Dialect.ArgumentPlaceholder()(currentArgNum)
-		// if placeholder is not unique identifier ($1, $2, etc..), we will replace just one occurrence of the argument
+		var placeholder string
 		toReplace := -1 // all occurrences
-		if placeholder == "?" {
-			toReplace = 1 // just one occurrence
-		}
 
-		if s.Debug {
-			placeholder = argToString(namedArgumentPos.Value)
+		if s.NamedArguments() {
+			placeholder = ":" + s.addNamedArgument(namedArgumentPos.Name, namedArgumentPos.Value)
+		} else {
+			s.Args = append(s.Args, namedArgumentPos.Value)
+			currentArgNum := len(s.Args)
+
+			placeholder = s.Dialect.ArgumentPlaceholder()(currentArgNum)
+			// if placeholder is not unique identifier ($1, $2, etc..), we will replace just one occurrence of the argument
+			if placeholder == "?" {
+				toReplace = 1 // just one occurrence
+			}
+
+			if s.Debug {
+				placeholder = s.argToString(namedArgumentPos.Value)
+			}
 		}
 
 		raw = strings.Replace(raw, namedArgumentPos.Name, placeholder, toReplace)
@@ -204,6 +428,81 @@ func (s *SQLBuilder) insertRawQuery(raw string, namedArg map[string]interface{})
 	s.WriteString(raw)
 }
 
+func (s *SQLBuilder) argToString(value interface{}) string {
+	value = bindValue(value)
+
+	if str, ok := s.Dialect.ArgumentToString(value); ok {
+		return str
+	}
+
+	return argToString(value)
+}
+
+// bindParameter converts values of defined types to the basic go type they are bound as(see bindValue).
+// Unlike bindValue, driver.Valuer implementations are passed to the driver unchanged, so that Value errors are
+// returned from the statement execution instead of panicking while query is built.
+func bindParameter(value interface{}) interface{} {
+	if utils.IsNil(value) {
+		return nil
+	}
+
+	if _, isValuer := value.(driver.Valuer); isValuer {
+		return value
+	}
+
+	if reflectValue := reflect.ValueOf(value); reflectValue.Kind() == reflect.Ptr {
+		return bindParameter(reflectValue.Elem().Interface())
+	}
+
+	return bindValue(value)
+}
+
+// bindValue converts driver.Valuer implementations and values of defined types(for instance enum types declared as
+// 'type Mood string') to the basic go type they are bound as, the same way database/sql converts driver arguments.
+// Pointers are dereferenced, and nil pointers are bound as NULL. Other values are returned unchanged.
+func bindValue(value interface{}) interface{} {
+	if utils.IsNil(value) {
+		return nil
+	}
+
+	if reflectValue := reflect.ValueOf(value); reflectValue.Kind() == reflect.Ptr {
+		if _, isValuer := value.(driver.Valuer); !isValuer {
+			return bindValue(reflectValue.Elem().Interface())
+		}
+	}
+
+	if valuer, ok := value.(driver.Valuer); ok {
+		driverValue, err := valuer.Value()
+
+		if err != nil {
+			panic(argumentError{fmt.Sprintf("jet: failed to bind %s parameter value, %s", reflect.TypeOf(value).String(), err)})
+		}
+
+		return driverValue
+	}
+
+	reflectValue := reflect.ValueOf(value)
+
+	if reflectValue.Type().PkgPath() == "" { // basic or unnamed type
+		return value
+	}
+
+	switch reflectValue.Kind() {
+	case reflect.String:
+		return reflectValue.String()
+	case reflect.Bool:
+		return reflectValue.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflectValue.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflectValue.Uint()
+	case reflect.Float32, reflect.Float64:
+		return reflectValue.Float()
+	}
+
+	return value
+}
+
 func argToString(value interface{}) string {
 	if utils.IsNil(value) {
 		return "NULL"
@@ -235,10 +534,20 @@ func argToString(value interface{}) string {
 		if strBindValue, ok := bindVal.(toStringInterface); ok {
 			return stringQuote(strBindValue.String())
 		}
-		panic(fmt.Sprintf("jet: %s type can not be used as SQL query parameter", reflect.TypeOf(value).String()))
+		panic(argumentError{fmt.Sprintf("jet: %s type can not be used as SQL query parameter", reflect.TypeOf(value).String())})
 	}
 }
 
+// argumentError is panic value raised when statement argument can not be bound as query parameter, or converted to
+// SQL literal. Statement execution methods recover it and return it as an error.
+type argumentError struct {
+	message string
+}
+
+func (a argumentError) Error() string {
+	return a.message
+}
+
 type toStringInterface interface {
 	String() string
 }
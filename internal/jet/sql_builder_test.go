@@ -1,8 +1,11 @@
 package jet
 
 import (
+	"database/sql/driver"
+	"errors"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
+	"strconv"
 	"testing"
 	"time"
 )
@@ -33,13 +36,66 @@ func TestArgToString(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, argToString(time), "'2006-01-02 15:04:05-07:00'")
 
-	func() {
-		defer func() {
-			require.Equal(t, recover().(string), "jet: map[string]bool type can not be used as SQL query parameter")
-		}()
-
+	require.PanicsWithError(t, "jet: map[string]bool type can not be used as SQL query parameter", func() {
 		argToString(map[string]bool{})
-	}()
+	})
+}
+
+type testEnum string
+
+type testNullEnum struct {
+	value string
+	valid bool
+}
+
+func (n testNullEnum) Value() (driver.Value, error) {
+	if !n.valid {
+		return nil, nil
+	}
+	return n.value, nil
+}
+
+type testFailingValuer struct{}
+
+func (testFailingValuer) Value() (driver.Value, error) {
+	return nil, errors.New("invalid value")
+}
+
+func TestBindValue(t *testing.T) {
+	require.Equal(t, "happy", bindValue(testEnum("happy")))
+	require.Equal(t, "happy", bindValue(testNullEnum{value: "happy", valid: true}))
+	require.Nil(t, bindValue(testNullEnum{}))
+	require.Nil(t, bindValue(nil))
+	require.Equal(t, int64(3), bindValue(time.Duration(3)))
+	require.Equal(t, 3, bindValue(3))
+	require.Equal(t, []byte("john"), bindValue([]byte("john")))
+
+	var nilString *string
+	john := "john"
+	mood := testEnum("happy")
+	require.Nil(t, bindValue(nilString))
+	require.Equal(t, "john", bindValue(&john))
+	require.Equal(t, "happy", bindValue(&mood))
+
+	require.PanicsWithError(t, "jet: failed to bind jet.testFailingValuer parameter value, invalid value", func() {
+		bindValue(testFailingValuer{})
+	})
+
+	mood = testEnum("sad")
+	require.Equal(t, "sad", bindParameter(&mood))
+	require.Nil(t, bindParameter(nilString))
+	require.Equal(t, testFailingValuer{}, bindParameter(testFailingValuer{}))
+
+	out := &SQLBuilder{Dialect: defaultDialect}
+	out.insertParametrizedArgument(testEnum("happy"))
+	out.insertParametrizedArgument(testNullEnum{})
+	out.insertParametrizedArgument(testFailingValuer{}) // valuers are passed to the driver, which returns Value error
+	require.Equal(t, []interface{}{"happy", testNullEnum{}, testFailingValuer{}}, out.Args)
+
+	out = &SQLBuilder{Dialect: defaultDialect, Debug: true}
+	out.insertParametrizedArgument(testEnum("sad"))
+	out.insertParametrizedArgument(testNullEnum{})
+	require.Equal(t, "'sad' NULL", out.Buff.String())
 }
 
 func TestFallTrough(t *testing.T) {
@@ -57,3 +113,33 @@ func TestShouldQuote(t *testing.T) {
 	require.Equal(t, shouldQuoteIdentifier("Abc_123"), true)
 	require.Equal(t, shouldQuoteIdentifier("ǄƜĐǶ"), true)
 }
+
+func TestWriteAttached(t *testing.T) {
+	out := SQLBuilder{}
+	out.WriteString("AS")
+	out.WriteString("alias")
+	out.writeAttached("(")
+	out.WriteString("col")
+	out.WriteByte(')')
+	out.writeAttached("")
+	require.Equal(t, "AS alias(col)", out.Buff.String())
+}
+
+func TestDeduplicateParameters(t *testing.T) {
+	out := &SQLBuilder{Dialect: NewDialect(DialectParams{ArgumentPlaceholder: func(ord int) string {
+		return "$" + strconv.Itoa(ord)
+	}}), deduplicateParameters: true}
+
+	for _, arg := range []interface{}{int64(1), []byte("a"), "1", int64(1), nil, []byte("a"), "1", nil, testNullEnum{}} {
+		out.insertParametrizedArgument(arg)
+	}
+
+	require.Equal(t, "$1 $2 $3 $1 $4 $2 $3 $4 $5", out.Buff.String())
+	require.Equal(t, []interface{}{int64(1), []byte("a"), "1", nil, testNullEnum{}}, out.Args)
+
+	require.True(t, isHashable(time.Now()))
+	require.True(t, isHashable([2]int{}))
+	require.False(t, isHashable(struct{ Value interface{} }{}))
+	require.False(t, isHashable([]byte("a")))
+	require.False(t, isHashable(nil))
+}
@@ -3,20 +3,28 @@ package jet
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"github.com/go-jet/jet/v2/qrm"
 	"time"
 )
 
 //Statement is common interface for all statements(SELECT, INSERT, UPDATE, DELETE, LOCK)
 type Statement interface {
-	// Sql returns parametrized sql query with list of arguments.
+	// Sql returns parametrized sql query with list of arguments. Panics if an argument can not be bound as query
+	// parameter, execution methods(Query, Exec, ...) return the same failure as an error.
 	Sql() (query string, args []interface{})
 	// DebugSql returns debug query where every parametrized placeholder is replaced with its argument.
 	// Do not use it in production. Use it only for debug purposes.
 	DebugSql() (query string)
+	// NamedSql returns sql query with :name parameter placeholders and map of named arguments, for database drivers
+	// using named parameters. Parameter names are derived from referenced column names. When the same column is
+	// referenced with different values, names are disambiguated with numeric suffix, for instance :id and :id_2.
+	NamedSql() (query string, args map[string]interface{}, err error)
 	// Query executes statement over database connection/transaction db and stores row result in destination.
 	// Destination can be either pointer to struct or pointer to a slice.
 	// If destination is pointer to struct and query result set is empty, method returns qrm.ErrNoRows.
+	// Nested struct pointer fields(one-to-one relations) are left nil if all of the related columns are NULL.
 	Query(db qrm.DB, destination interface{}) error
 	// QueryContext executes statement with a context over database connection/transaction db and stores row result in destination.
 	// Destination can be either pointer to struct or pointer to a slice.
@@ -28,13 +36,80 @@ type Statement interface {
 	ExecContext(ctx context.Context, db qrm.DB) (sql.Result, error)
 	// Rows executes statements over db connection/transaction and returns rows
 	Rows(ctx context.Context, db qrm.DB) (*Rows, error)
+	// Prepare prepares statement on the database server, and immediately closes prepared statement without executing
+	// it. Returned error reports statement syntax or type errors, so it can be used in tests as a cheap check that the
+	// statement compiles on the target database. db has to be able to prepare statements (*sql.DB, *sql.Tx or *sql.Conn).
+	Prepare(ctx context.Context, db qrm.DB) error
+	// InlineParameters returns statement whose every parameter is inlined into sql query as escaped literal.
+	// Inlined statement is executed without arguments. Use it only with drivers or proxies that do not handle
+	// parametrized (prepared) statements well.
+	InlineParameters() Statement
+	// WithIsolation returns statement which is, when executed over db connection pool(or any other db able to begin
+	// transaction), executed inside new transaction with isolation level. Transaction is committed after the execution,
+	// or in case of Rows method, when returned rows are closed. Statement executed over transaction is executed as is.
+	WithIsolation(level sql.IsolationLevel) Statement
+	// RewriteNullComparisons returns statement in which equality comparisons with NULL, or with nil pointer literal
+	// (for instance StringPtr(nil)), are serialized as IS NULL, and inequality comparisons as IS NOT NULL.
+	// Note that this changes statement semantics, because in SQL 'expression = NULL' is never true.
+	RewriteNullComparisons() Statement
+	// WithIdentifierCase returns statement whose schema, table and column identifiers are written using identifierCase
+	// rules. For instance, IdentifierCasePreserve quotes every identifier, so that MixedCase names are matched exactly.
+	WithIdentifierCase(identifierCase IdentifierCase) Statement
+	// WithLabels returns statement with labels attached. Labels are passed, together with the other query information,
+	// to the query logger function(see SetQueryLogger), and can be used, for instance, to tag query metrics.
+	// Labels are merged with labels already attached to the statement.
+	WithLabels(labels map[string]string) Statement
+	// DeduplicateParameters returns statement in which identical parameter values are bound once, and the same
+	// placeholder is reused wherever the value appears again, for instance: tenant_id = $1 ... tenant_id = $1.
+	// Parameters are deduplicated only for dialects with numbered placeholders(PostgreSQL), because positional
+	// placeholders(?) can not be reused.
+	DeduplicateParameters() Statement
+
+	executableSqlContext(ctx context.Context) (query string, args []interface{}, err error)
+	statementDialect() Dialect
 }
 
+// SqlContext returns parametrized sql query and list of arguments of the statement, as it would be executed with ctx.
+// Schema override from ctx(see WithSchema) is applied. Missing mandatory WHERE clause and invalid arguments are
+// returned as an error, the same as from statement execution methods.
+func SqlContext(ctx context.Context, statement Statement) (query string, args []interface{}, err error) {
+	return statement.executableSqlContext(ctx)
+}
+
+// ErrTooManyParameters is returned, before statement execution, when statement has more parameters than dialect
+// allows in a single statement. For instance, PostgreSQL allows at most 65535 parameters per statement.
+var ErrTooManyParameters = errors.New("too many statement parameters")
+
+// ErrMissingWhere is returned, before statement execution, when UPDATE or DELETE statement has no WHERE clause and
+// is not explicitly marked with AllRows.
+var ErrMissingWhere = errors.New("missing WHERE clause")
+
 // Rows wraps sql.Rows type to add query result mapping for Scan method
 type Rows struct {
 	*sql.Rows
 
 	scanContext *qrm.ScanContext
+	tx          *sql.Tx
+}
+
+// Close closes the rows. If rows are result of statement executed with isolation level(see WithIsolation),
+// underlying transaction is committed as well.
+func (r *Rows) Close() error {
+	err := r.Rows.Close()
+
+	if r.tx == nil {
+		return err
+	}
+
+	tx := r.tx
+	r.tx = nil
+
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
 }
 
 // Scan will map the Row values into struct destination
@@ -62,14 +137,31 @@ type SerializerHasProjections interface {
 
 // serializerStatementInterfaceImpl struct
 type serializerStatementInterfaceImpl struct {
-	dialect       Dialect
-	statementType StatementType
-	parent        SerializerStatement
+	dialect          Dialect
+	statementType    StatementType
+	parent           SerializerStatement
+	inlineParameters bool
+	schema           string
+	txOptions        *sql.TxOptions
+
+	rewriteNullComparisons bool
+	identifierCase         IdentifierCase
+	labels                 map[string]string
+	deduplicateParameters  bool
 }
 
 func (s *serializerStatementInterfaceImpl) Sql() (query string, args []interface{}) {
+	if s.inlineParameters {
+		return s.DebugSql(), nil
+	}
 
-	queryData := &SQLBuilder{Dialect: s.dialect}
+	queryData := &SQLBuilder{
+		Dialect:                s.dialect,
+		schema:                 s.schema,
+		rewriteNullComparisons: s.rewriteNullComparisons,
+		identifierCase:         s.identifierCase,
+		deduplicateParameters:  s.deduplicateParameters,
+	}
 
 	s.parent.serialize(s.statementType, queryData, NoWrap)
 
@@ -77,8 +169,36 @@ func (s *serializerStatementInterfaceImpl) Sql() (query string, args []interface
 	return
 }
 
+func (s *serializerStatementInterfaceImpl) NamedSql() (query string, args map[string]interface{}, err error) {
+	defer s.recoverSerializeError(&err)
+
+	if s.inlineParameters {
+		return s.DebugSql(), map[string]interface{}{}, nil
+	}
+
+	queryData := &SQLBuilder{
+		Dialect:                s.dialect,
+		schema:                 s.schema,
+		rewriteNullComparisons: s.rewriteNullComparisons,
+		identifierCase:         s.identifierCase,
+		namedArgs:              map[string]interface{}{},
+	}
+
+	s.parent.serialize(s.statementType, queryData, NoWrap)
+
+	query, _ = queryData.finalize()
+
+	return query, queryData.namedArgs, nil
+}
+
 func (s *serializerStatementInterfaceImpl) DebugSql() (query string) {
-	sqlBuilder := &SQLBuilder{Dialect: s.dialect, Debug: true}
+	sqlBuilder := &SQLBuilder{
+		Dialect:                s.dialect,
+		Debug:                  true,
+		schema:                 s.schema,
+		rewriteNullComparisons: s.rewriteNullComparisons,
+		identifierCase:         s.identifierCase,
+	}
 
 	s.parent.serialize(s.statementType, sqlBuilder, NoWrap)
 
@@ -86,20 +206,212 @@ func (s *serializerStatementInterfaceImpl) DebugSql() (query string) {
 	return
 }
 
+func (s *serializerStatementInterfaceImpl) InlineParameters() Statement {
+	inlined := *s
+	inlined.inlineParameters = true
+	return &inlined
+}
+
+func (s *serializerStatementInterfaceImpl) RewriteNullComparisons() Statement {
+	rewritten := *s
+	rewritten.rewriteNullComparisons = true
+	return &rewritten
+}
+
+func (s *serializerStatementInterfaceImpl) DeduplicateParameters() Statement {
+	deduplicated := *s
+	deduplicated.deduplicateParameters = true
+	return &deduplicated
+}
+
+func (s *serializerStatementInterfaceImpl) WithIdentifierCase(identifierCase IdentifierCase) Statement {
+	cased := *s
+	cased.identifierCase = identifierCase
+	return &cased
+}
+
+func (s *serializerStatementInterfaceImpl) WithLabels(labels map[string]string) Statement {
+	labeled := *s
+	labeled.labels = make(map[string]string, len(s.labels)+len(labels))
+
+	for name, value := range s.labels {
+		labeled.labels[name] = value
+	}
+
+	for name, value := range labels {
+		labeled.labels[name] = value
+	}
+
+	return &labeled
+}
+
+// withContextSchema returns copy of statement bound to schema override stored in ctx(see WithSchema),
+// or statement itself if ctx does not contain schema override.
+func (s *serializerStatementInterfaceImpl) withContextSchema(ctx context.Context) *serializerStatementInterfaceImpl {
+	schema, ok := SchemaFromContext(ctx)
+
+	if !ok {
+		return s
+	}
+
+	bound := *s
+	bound.schema = schema
+	return &bound
+}
+
+func (s *serializerStatementInterfaceImpl) executableSqlContext(ctx context.Context) (query string, args []interface{}, err error) {
+	return s.withContextSchema(ctx).executableSql()
+}
+
+func (s *serializerStatementInterfaceImpl) statementDialect() Dialect {
+	return s.dialect
+}
+
+func (s *serializerStatementInterfaceImpl) WithIsolation(level sql.IsolationLevel) Statement {
+	isolated := *s
+	isolated.txOptions = &sql.TxOptions{Isolation: level}
+	return &isolated
+}
+
+// checkParameters returns error if number of statement arguments exceeds dialect parameters limit
+func (s *serializerStatementInterfaceImpl) checkParameters(args []interface{}) error {
+	maxParameters := s.dialect.MaxParameters()
+
+	if maxParameters > 0 && len(args) > maxParameters {
+		return fmt.Errorf("jet: statement has %d parameters, but %s supports at most %d parameters per statement, %w",
+			len(args), s.dialect.Name(), maxParameters, ErrTooManyParameters)
+	}
+
+	return nil
+}
+
+// executableSql returns parametrized sql query and arguments of the statement about to be executed. Missing mandatory
+// WHERE clause, invalid arguments and too many statement parameters are returned as an error.
+func (s *serializerStatementInterfaceImpl) executableSql() (query string, args []interface{}, err error) {
+	defer s.recoverSerializeError(&err)
+
+	query, args = s.Sql()
+
+	if err := s.checkParameters(args); err != nil {
+		return "", nil, err
+	}
+
+	return query, args, nil
+}
+
+// recoverSerializeError recovers missing mandatory WHERE clause panic into ErrMissingWhere error, and invalid
+// statement argument panic into an error. Other panics are repanicked.
+func (s *serializerStatementInterfaceImpl) recoverSerializeError(err *error) {
+	if recovered := recover(); recovered != nil {
+		if argumentErr, ok := recovered.(argumentError); ok {
+			*err = argumentErr
+			return
+		}
+
+		if recovered != missingWhereClause {
+			panic(recovered)
+		}
+
+		*err = fmt.Errorf("jet: %s statement without WHERE clause affects all rows, use AllRows to allow it, %w",
+			s.statementType, ErrMissingWhere)
+	}
+}
+
+type preparer interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+func (s *serializerStatementInterfaceImpl) Prepare(ctx context.Context, db qrm.DB) error {
+	s = s.withContextSchema(ctx)
+	query, _, err := s.executableSql()
+
+	if err != nil {
+		return err
+	}
+
+	dbPreparer, ok := db.(preparer)
+
+	if !ok {
+		return fmt.Errorf("jet: %T can not prepare statements", db)
+	}
+
+	stmt, err := dbPreparer.PrepareContext(ctx, query)
+
+	if err != nil {
+		return fmt.Errorf("jet: failed to prepare statement, %w", err)
+	}
+
+	return stmt.Close()
+}
+
+type txBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// beginIsolatedTx begins new transaction with statement isolation level, if statement isolation level is set and
+// db can begin transaction. Otherwise, nil transaction is returned.
+func (s *serializerStatementInterfaceImpl) beginIsolatedTx(ctx context.Context, db qrm.DB) (*sql.Tx, error) {
+	beginner, ok := db.(txBeginner)
+
+	if s.txOptions == nil || !ok {
+		return nil, nil
+	}
+
+	tx, err := beginner.BeginTx(ctx, s.txOptions)
+
+	if err != nil {
+		return nil, fmt.Errorf("jet: failed to begin transaction, %w", err)
+	}
+
+	return tx, nil
+}
+
+// inIsolatedTx executes f inside new transaction with statement isolation level(see beginIsolatedTx), or over db
+// if transaction is not needed. Transaction is committed if f succeeds, otherwise is rolled back.
+func (s *serializerStatementInterfaceImpl) inIsolatedTx(ctx context.Context, db qrm.DB, f func(db qrm.DB) error) error {
+	tx, err := s.beginIsolatedTx(ctx, db)
+
+	if err != nil {
+		return err
+	}
+
+	if tx == nil {
+		return f(db)
+	}
+
+	if err := f(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("jet: failed to commit transaction, %w", err)
+	}
+
+	return nil
+}
+
 func (s *serializerStatementInterfaceImpl) Query(db qrm.DB, destination interface{}) error {
 	return s.QueryContext(context.Background(), db, destination)
 }
 
 func (s *serializerStatementInterfaceImpl) QueryContext(ctx context.Context, db qrm.DB, destination interface{}) error {
-	query, args := s.Sql()
+	s = s.withContextSchema(ctx)
+	query, args, err := s.executableSql()
+
+	if err != nil {
+		return err
+	}
 
 	callLogger(ctx, s)
 
 	var rowsProcessed int64
-	var err error
 
 	duration := duration(func() {
-		rowsProcessed, err = qrm.Query(ctx, db, query, args, destination)
+		err = s.inIsolatedTx(ctx, db, func(db qrm.DB) (err error) {
+			rowsProcessed, err = qrm.Query(ctx, db, query, args, destination)
+			return err
+		})
 	})
 
 	callQueryLoggerFunc(ctx, QueryInfo{
@@ -107,6 +419,7 @@ func (s *serializerStatementInterfaceImpl) QueryContext(ctx context.Context, db
 		RowsProcessed: rowsProcessed,
 		Duration:      duration,
 		Err:           err,
+		Labels:        s.labels,
 	})
 
 	return err
@@ -117,12 +430,20 @@ func (s *serializerStatementInterfaceImpl) Exec(db qrm.DB) (res sql.Result, err
 }
 
 func (s *serializerStatementInterfaceImpl) ExecContext(ctx context.Context, db qrm.DB) (res sql.Result, err error) {
-	query, args := s.Sql()
+	s = s.withContextSchema(ctx)
+	query, args, err := s.executableSql()
+
+	if err != nil {
+		return nil, err
+	}
 
 	callLogger(ctx, s)
 
 	duration := duration(func() {
-		res, err = db.ExecContext(ctx, query, args...)
+		err = s.inIsolatedTx(ctx, db, func(db qrm.DB) (err error) {
+			res, err = db.ExecContext(ctx, query, args...)
+			return err
+		})
 	})
 
 	var rowsAffected int64
@@ -136,42 +457,67 @@ func (s *serializerStatementInterfaceImpl) ExecContext(ctx context.Context, db q
 		RowsProcessed: rowsAffected,
 		Duration:      duration,
 		Err:           err,
+		Labels:        s.labels,
 	})
 
 	return res, err
 }
 
 func (s *serializerStatementInterfaceImpl) Rows(ctx context.Context, db qrm.DB) (*Rows, error) {
-	query, args := s.Sql()
+	s = s.withContextSchema(ctx)
+	query, args, err := s.executableSql()
+
+	if err != nil {
+		return nil, err
+	}
 
 	callLogger(ctx, s)
 
 	var rows *sql.Rows
-	var err error
+	var tx *sql.Tx
 
 	duration := duration(func() {
-		rows, err = db.QueryContext(ctx, query, args...)
+		tx, err = s.beginIsolatedTx(ctx, db)
+
+		if err != nil {
+			return
+		}
+
+		if tx != nil {
+			rows, err = tx.QueryContext(ctx, query, args...)
+		} else {
+			rows, err = db.QueryContext(ctx, query, args...)
+		}
 	})
 
 	callQueryLoggerFunc(ctx, QueryInfo{
 		Statement: s,
 		Duration:  duration,
 		Err:       err,
+		Labels:    s.labels,
 	})
 
 	if err != nil {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
 		return nil, err
 	}
 
 	scanContext, err := qrm.NewScanContext(rows)
 
 	if err != nil {
+		_ = rows.Close()
+		if tx != nil {
+			_ = tx.Rollback()
+		}
 		return nil, err
 	}
 
 	return &Rows{
 		Rows:        rows,
 		scanContext: scanContext,
+		tx:          tx,
 	}, nil
 }
 
@@ -252,10 +598,15 @@ func (s *statementImpl) serialize(statement StatementType, out *SQLBuilder, opti
 		out.IncreaseIdent()
 	}
 
+	out.columnScope = out.columnScope.enter()
+
 	for _, clause := range s.Clauses {
-		clause.Serialize(s.statementType, out, FallTrough(options)...)
+		clause.Serialize(s.statementType, out, subQueryOptions(options)...)
+		out.parameterName = ""
 	}
 
+	out.columnScope = out.columnScope.leave()
+
 	if contains(options, Ident) {
 		out.DecreaseIdent()
 		out.NewLine()
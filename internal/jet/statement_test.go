@@ -0,0 +1,204 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// txRecorderConn is fake driver connection, which records transactions and executed queries
+type txRecorderConn struct {
+	isolationLevels []sql.IsolationLevel
+	commits         int
+	rollbacks       int
+	queries         []string
+	execErr         error
+	prepared        []string
+	prepareErr      error
+	closedStmts     int
+}
+
+func (c *txRecorderConn) Open(name string) (driver.Conn, error) { return c, nil }
+func (c *txRecorderConn) Prepare(query string) (driver.Stmt, error) {
+	if c.prepareErr != nil {
+		return nil, c.prepareErr
+	}
+	c.prepared = append(c.prepared, query)
+	return &recorderStmt{conn: c}, nil
+}
+func (c *txRecorderConn) Close() error { return nil }
+func (c *txRecorderConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+func (c *txRecorderConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	c.isolationLevels = append(c.isolationLevels, sql.IsolationLevel(opts.Isolation))
+	return c, nil
+}
+func (c *txRecorderConn) Commit() error   { c.commits++; return nil }
+func (c *txRecorderConn) Rollback() error { c.rollbacks++; return nil }
+func (c *txRecorderConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.queries = append(c.queries, query)
+	return driver.RowsAffected(1), c.execErr
+}
+
+// recorderStmt is fake prepared statement, which records when it is closed
+type recorderStmt struct {
+	conn *txRecorderConn
+}
+
+func (s *recorderStmt) Close() error  { s.conn.closedStmts++; return nil }
+func (s *recorderStmt) NumInput() int { return -1 }
+func (s *recorderStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("exec not supported")
+}
+func (s *recorderStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return nil, errors.New("query not supported")
+}
+
+func TestStatementWithIsolation(t *testing.T) {
+	conn := &txRecorderConn{}
+	sql.Register("jet-tx-recorder", conn)
+
+	db, err := sql.Open("jet-tx-recorder", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	stmt := RawStatement(defaultDialect, "UPDATE table1 SET col1 = 1")
+
+	_, err = stmt.WithIsolation(sql.LevelRepeatableRead).Exec(db)
+	require.NoError(t, err)
+	require.Equal(t, []sql.IsolationLevel{sql.LevelRepeatableRead}, conn.isolationLevels)
+	require.Equal(t, 1, conn.commits)
+
+	_, err = stmt.Exec(db)
+	require.NoError(t, err)
+	require.Len(t, conn.isolationLevels, 1)
+
+	conn.execErr = errors.New("exec failed")
+	_, err = stmt.WithIsolation(sql.LevelSerializable).Exec(db)
+	require.EqualError(t, err, "exec failed")
+	require.Equal(t, []sql.IsolationLevel{sql.LevelRepeatableRead, sql.LevelSerializable}, conn.isolationLevels)
+	require.Equal(t, 1, conn.commits)
+	require.Equal(t, 1, conn.rollbacks)
+	conn.execErr = nil
+
+	// statement executed over existing transaction does not begin new one
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	_, err = stmt.WithIsolation(sql.LevelSerializable).Exec(tx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	require.Len(t, conn.isolationLevels, 3)
+	require.Equal(t, 2, conn.commits)
+
+	require.Len(t, conn.queries, 4)
+	require.Equal(t, "UPDATE table1 SET col1 = 1;\n", conn.queries[0])
+}
+
+func TestStatementMaxParameters(t *testing.T) {
+	dialect := NewDialect(DialectParams{
+		Name:                "TestDB",
+		ArgumentPlaceholder: defaultDialect.ArgumentPlaceholder(),
+		MaxParameters:       2,
+	})
+
+	stmt := RawStatement(dialect, "UPDATE table1 SET col1 = #1, col2 = #2, col3 = #3", map[string]interface{}{"#1": 1, "#2": 2, "#3": 3})
+
+	_, err := stmt.Exec(nil)
+	require.True(t, errors.Is(err, ErrTooManyParameters))
+	require.EqualError(t, err, "jet: statement has 3 parameters, but TestDB supports at most 2 parameters per statement, too many statement parameters")
+
+	err = stmt.Query(nil, &struct{}{})
+	require.True(t, errors.Is(err, ErrTooManyParameters))
+
+	_, err = stmt.Rows(context.Background(), nil)
+	require.True(t, errors.Is(err, ErrTooManyParameters))
+
+	// inlined statement is executed without parameters
+	conn := &txRecorderConn{}
+	sql.Register("jet-max-parameters", conn)
+	db, err := sql.Open("jet-max-parameters", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = stmt.InlineParameters().Exec(db)
+	require.NoError(t, err)
+	require.Equal(t, []string{"UPDATE table1 SET col1 = 1, col2 = 2, col3 = 3;\n"}, conn.queries)
+}
+
+func TestStatementWithLabels(t *testing.T) {
+	conn := &txRecorderConn{}
+	sql.Register("jet-labels", conn)
+	db, err := sql.Open("jet-labels", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	var loggedLabels []map[string]string
+	SetQueryLogger(func(ctx context.Context, info QueryInfo) {
+		loggedLabels = append(loggedLabels, info.Labels)
+	})
+	defer SetQueryLogger(nil)
+
+	stmt := RawStatement(defaultDialect, "UPDATE table1 SET col1 = 1")
+	labeled := stmt.WithLabels(map[string]string{"endpoint": "/users", "tier": "free"})
+
+	_, err = stmt.Exec(db)
+	require.NoError(t, err)
+	_, err = labeled.Exec(db)
+	require.NoError(t, err)
+	_, err = labeled.WithLabels(map[string]string{"tier": "premium"}).ExecContext(context.Background(), db)
+	require.NoError(t, err)
+
+	require.Equal(t, []map[string]string{
+		nil,
+		{"endpoint": "/users", "tier": "free"},
+		{"endpoint": "/users", "tier": "premium"},
+	}, loggedLabels)
+}
+
+func TestStatementPrepare(t *testing.T) {
+	conn := &txRecorderConn{}
+	sql.Register("jet-prepare", conn)
+	db, err := sql.Open("jet-prepare", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	stmt := RawStatement(defaultDialect, "UPDATE table1 SET col1 = #1 WHERE col2 = #2", map[string]interface{}{"#1": 1, "#2": 2})
+
+	require.NoError(t, stmt.Prepare(context.Background(), db))
+	require.Equal(t, []string{"UPDATE table1 SET col1 = $1 WHERE col2 = $2;\n"}, conn.prepared)
+	require.Equal(t, 1, conn.closedStmts)
+	require.Empty(t, conn.queries)
+
+	conn.prepareErr = errors.New("syntax error at or near \"UPDATE\"")
+	err = stmt.Prepare(context.Background(), db)
+	require.EqualError(t, err, "jet: failed to prepare statement, syntax error at or near \"UPDATE\"")
+	require.True(t, errors.Is(err, conn.prepareErr))
+
+	require.EqualError(t, stmt.Prepare(context.Background(), &queryOnlyDB{}),
+		"jet: *jet.queryOnlyDB can not prepare statements")
+}
+
+// queryOnlyDB is qrm.DB which can not prepare statements
+type queryOnlyDB struct {
+	qrm.DB
+}
+
+func TestStatementInvalidArgument(t *testing.T) {
+	stmt := RawStatement(defaultDialect, "UPDATE table1 SET col1 = #1", map[string]interface{}{"#1": testFailingValuer{}})
+
+	_, err := stmt.InlineParameters().Exec(nil)
+	require.EqualError(t, err, "jet: failed to bind jet.testFailingValuer parameter value, invalid value")
+
+	err = RawStatement(defaultDialect, "SELECT #1", map[string]interface{}{"#1": map[string]bool{}}).
+		InlineParameters().Query(nil, &struct{}{})
+	require.EqualError(t, err, "jet: map[string]bool type can not be used as SQL query parameter")
+
+	require.PanicsWithError(t, "jet: failed to bind jet.testFailingValuer parameter value, invalid value", func() {
+		stmt.DebugSql()
+	})
+}
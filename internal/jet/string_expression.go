@@ -1,5 +1,7 @@
 package jet
 
+import "strings"
+
 // StringExpression interface
 type StringExpression interface {
 	Expression
@@ -20,6 +22,7 @@ type StringExpression interface {
 
 	LIKE(pattern StringExpression) BoolExpression
 	NOT_LIKE(pattern StringExpression) BoolExpression
+	CONTAINS(text string) BoolExpression
 
 	REGEXP_LIKE(pattern StringExpression, caseSensitive ...bool) BoolExpression
 	NOT_REGEXP_LIKE(pattern StringExpression, caseSensitive ...bool) BoolExpression
@@ -81,6 +84,11 @@ func (s *stringInterfaceImpl) NOT_LIKE(pattern StringExpression) BoolExpression
 	return newBinaryBoolOperatorExpression(s.parent, pattern, "NOT LIKE")
 }
 
+// CONTAINS matches strings containing text. LIKE wildcards in text are escaped, so text is matched literally.
+func (s *stringInterfaceImpl) CONTAINS(text string) BoolExpression {
+	return newBinaryBoolOperatorExpression(s.parent, newEscapedLikePattern("%"+EscapeLike(text)+"%"), "LIKE")
+}
+
 func (s *stringInterfaceImpl) REGEXP_LIKE(pattern StringExpression, caseSensitive ...bool) BoolExpression {
 	return newBinaryBoolOperatorExpression(s.parent, pattern, StringRegexpLikeOperator, Bool(len(caseSensitive) > 0 && caseSensitive[0]))
 }
@@ -89,6 +97,39 @@ func (s *stringInterfaceImpl) NOT_REGEXP_LIKE(pattern StringExpression, caseSens
 	return newBinaryBoolOperatorExpression(s.parent, pattern, StringNotRegexpLikeOperator, Bool(len(caseSensitive) > 0 && caseSensitive[0]))
 }
 
+//---------------------------------------------------//
+
+// LikeEscapeChar is escape character used by EscapeLike and CONTAINS patterns
+const LikeEscapeChar = `\`
+
+var likeEscapeReplacer = strings.NewReplacer(LikeEscapeChar, LikeEscapeChar+LikeEscapeChar, "%", LikeEscapeChar+"%", "_", LikeEscapeChar+"_")
+
+// EscapeLike escapes LIKE wildcards('%' and '_') and LikeEscapeChar in value. Pattern containing escaped value
+// has to be used with ESCAPE clause, for instance CONTAINS.
+func EscapeLike(value string) string {
+	return likeEscapeReplacer.Replace(value)
+}
+
+// escapedLikePattern serializes LIKE pattern followed by ESCAPE clause: pattern ESCAPE '\'
+type escapedLikePattern struct {
+	ExpressionInterfaceImpl
+
+	pattern string
+}
+
+func newEscapedLikePattern(pattern string) Expression {
+	likePattern := &escapedLikePattern{pattern: pattern}
+	likePattern.ExpressionInterfaceImpl.Parent = likePattern
+
+	return likePattern
+}
+
+func (e *escapedLikePattern) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.insertParametrizedArgument(e.pattern)
+	out.WriteString("ESCAPE")
+	out.insertConstantArgument(LikeEscapeChar)
+}
+
 //---------------------------------------------------//
 func newBinaryStringOperatorExpression(lhs, rhs Expression, operator string) StringExpression {
 	return StringExp(NewBinaryOperatorExpression(lhs, rhs, operator))
@@ -107,6 +148,10 @@ func newStringExpressionWrap(expression Expression) StringExpression {
 	return &stringExpressionWrap
 }
 
+func (s *stringExpressionWrapper) unwrap() Serializer {
+	return s.Expression
+}
+
 // StringExp is string expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as string expression.
 // Does not add sql cast to generated sql builder output.
@@ -1,6 +1,7 @@
 package jet
 
 import (
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
@@ -66,6 +67,18 @@ func TestStringNOT_LIKE(t *testing.T) {
 	assertClauseSerialize(t, table3StrCol.NOT_LIKE(String("JOHN")), "(table3.col2 NOT LIKE $1)", "JOHN")
 }
 
+func TestStringCONTAINS(t *testing.T) {
+	assertClauseSerialize(t, table3StrCol.CONTAINS("JOHN"), `(table3.col2 LIKE $1 ESCAPE '\')`, "%JOHN%")
+	assertClauseSerialize(t, table3StrCol.CONTAINS(`100%_sure\`), `(table3.col2 LIKE $1 ESCAPE '\')`, `%100\%\_sure\\%`)
+	assertClauseDebugSerialize(t, table3StrCol.CONTAINS("50%"), `(table3.col2 LIKE '%50\%%' ESCAPE '\')`)
+}
+
+func TestEscapeLike(t *testing.T) {
+	require.Equal(t, "", EscapeLike(""))
+	require.Equal(t, "john", EscapeLike("john"))
+	require.Equal(t, `100\% \_ \\`, EscapeLike(`100% _ \`))
+}
+
 func TestStringREGEXP_LIKE(t *testing.T) {
 	assertClauseSerialize(t, table3StrCol.REGEXP_LIKE(table2ColStr), "(table3.col2 REGEXP table2.col_str)")
 	assertClauseSerialize(t, table3StrCol.REGEXP_LIKE(String("JOHN"), true), "(table3.col2 REGEXP $1)", "JOHN")
@@ -0,0 +1,86 @@
+package jet
+
+import "fmt"
+
+// SubQueryExpression is select statement used as an expression operand
+type SubQueryExpression interface {
+	Expression
+	HasProjections
+}
+
+func mustProjectSingleColumnOfType(expression Expression, subQuery SubQueryExpression, operator string) {
+	if subQuery == nil {
+		panic(fmt.Sprintf("jet: %s sub-query is nil", operator))
+	}
+
+	projections := flattenProjections(subQuery.projections())
+
+	if len(projections) != 1 {
+		panic(fmt.Sprintf("jet: %s sub-query has to project exactly one column, got %d", operator, len(projections)))
+	}
+
+	projectedExpression, ok := unwrapProjection(projections[0]).(Expression)
+
+	if !ok {
+		return
+	}
+
+	expressionType, projectedType := expressionTypeName(expression), expressionTypeName(projectedExpression)
+
+	if expressionType != "" && projectedType != "" && expressionType != projectedType {
+		panic(fmt.Sprintf("jet: %s sub-query projects %s column, but %s expression is expected", operator, projectedType, expressionType))
+	}
+}
+
+func flattenProjections(projections []Projection) []Projection {
+	var ret []Projection
+
+	for _, projection := range projections {
+		switch p := projection.(type) {
+		case ProjectionList:
+			ret = append(ret, flattenProjections(p)...)
+		case ColumnList:
+			for _, column := range p {
+				ret = append(ret, column)
+			}
+		default:
+			ret = append(ret, projection)
+		}
+	}
+
+	return ret
+}
+
+func unwrapProjection(projection Projection) Projection {
+	if alias, ok := projection.(*alias); ok {
+		return alias.expression
+	}
+
+	return projection
+}
+
+// expressionTypeName returns type name of typed expression, or empty string for untyped expressions
+func expressionTypeName(expression Expression) string {
+	switch expression.(type) {
+	case BoolExpression:
+		return "bool"
+	case IntegerExpression:
+		return "integer"
+	case FloatExpression:
+		return "float"
+	case StringExpression:
+		return "string"
+	case DateExpression:
+		return "date"
+	case TimeExpression:
+		return "time"
+	case TimezExpression:
+		return "timez"
+	case TimestampExpression:
+		return "timestamp"
+	case TimestampzExpression:
+		return "timestampz"
+	}
+
+	return ""
+}
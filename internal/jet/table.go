@@ -1,7 +1,10 @@
 package jet
 
 import (
+	"fmt"
 	"github.com/go-jet/jet/v2/internal/utils"
+	"strings"
+	"sync"
 )
 
 // SerializerTable interface
@@ -16,6 +19,9 @@ type Table interface {
 	SchemaName() string
 	TableName() string
 	Alias() string
+	// QualifiedName returns schema qualified table name, with schema and table name always quoted using dialect
+	// identifier quote character, for instance "schema"."table" for PostgreSQL.
+	QualifiedName(dialect Dialect) string
 }
 
 // NewTable creates new table with schema Name, table Name and list of columns
@@ -46,6 +52,84 @@ type tableImpl struct {
 	name       string
 	alias      string
 	columnList []ColumnExpression
+
+	inheritance tableInheritance
+	indexHints  []indexHint
+}
+
+// tableInheritance is optional table inheritance modifier, which selects if rows from descendant tables are included
+type tableInheritance int
+
+const (
+	inheritanceDefault tableInheritance = iota
+	inheritanceOnly
+	inheritanceDescendants
+)
+
+// OnlyTable returns copy of table serialized with ONLY modifier, for instance: ONLY schema.table AS alias.
+// Rows from descendant tables(inheriting tables and partitions) are excluded.
+func OnlyTable(table SerializerTable) SerializerTable {
+	return withTableInheritance(table, inheritanceOnly)
+}
+
+// TableWithDescendants returns copy of table serialized with * modifier, for instance: schema.table * AS alias.
+// Rows from descendant tables(inheriting tables and partitions) are explicitly included.
+func TableWithDescendants(table SerializerTable) SerializerTable {
+	return withTableInheritance(table, inheritanceDescendants)
+}
+
+func withTableInheritance(table SerializerTable, inheritance tableInheritance) SerializerTable {
+	t, ok := table.(*tableImpl)
+
+	if !ok {
+		panic("jet: ONLY and * modifiers are supported only for tables")
+	}
+
+	newTable := *t
+	newTable.inheritance = inheritance
+
+	return &newTable
+}
+
+// IndexHintType is type of MySQL table index hint
+type IndexHintType string
+
+// Table index hint types
+const (
+	UseIndex    IndexHintType = "USE INDEX"
+	ForceIndex  IndexHintType = "FORCE INDEX"
+	IgnoreIndex IndexHintType = "IGNORE INDEX"
+)
+
+type indexHint struct {
+	hintType IndexHintType
+	indexes  []string
+}
+
+// TableWithIndexHint returns copy of table with index hint appended to the previously set index hints, for instance:
+// schema.table AS alias USE INDEX (index1, index2). Hints are serialized in the order they are added.
+// Panics if index list is empty or any of the index names is empty.
+func TableWithIndexHint(table SerializerTable, hintType IndexHintType, indexes ...string) SerializerTable {
+	t, ok := table.(*tableImpl)
+
+	if !ok {
+		panic("jet: index hints are supported only for tables")
+	}
+
+	if len(indexes) == 0 {
+		panic(fmt.Sprintf("jet: %s hint requires at least one index name", hintType))
+	}
+
+	for _, index := range indexes {
+		if strings.TrimSpace(index) == "" {
+			panic(fmt.Sprintf("jet: %s hint has invalid index name '%s'", hintType, index))
+		}
+	}
+
+	newTable := *t
+	newTable.indexHints = append(append([]indexHint{}, t.indexHints...), indexHint{hintType: hintType, indexes: indexes})
+
+	return &newTable
 }
 
 func (t *tableImpl) SchemaName() string {
@@ -66,27 +150,137 @@ func (t *tableImpl) columns() []Column {
 	return ret
 }
 
+// TableProjections returns list of all the table columns, in the order they are declared in the table
+func TableProjections(table Table) ProjectionList {
+	ret := ProjectionList{}
+
+	for _, column := range table.columns() {
+		if projection, ok := column.(Projection); ok {
+			ret = append(ret, projection)
+		}
+	}
+
+	return ret
+}
+
+// LookupColumn returns table column with the name, as it was registered with the table. Returned column keeps its
+// type and generated and default value flags. Error is returned if table does not have the column.
+func LookupColumn(table Table, name string) (ColumnExpression, error) {
+	for _, column := range table.columns() {
+		if column.Name() != name {
+			continue
+		}
+
+		if columnExpression, ok := column.(ColumnExpression); ok {
+			return columnExpression, nil
+		}
+	}
+
+	return nil, fmt.Errorf("jet: column '%s' not found in table '%s'", name, tableNameOrAlias(table))
+}
+
+// TableColumn returns table column with the name. If table does not have the column, returned column panics with
+// lookup error when serialized.
+func TableColumn(table Table, name string) ColumnExpression {
+	column, err := LookupColumn(table, name)
+
+	if err != nil {
+		return newMissingColumn(name, err)
+	}
+
+	return column
+}
+
+// missingColumn is placeholder for the column not found in the table, which defers lookup error into serialization
+type missingColumn struct {
+	ColumnExpressionImpl
+
+	err error
+}
+
+func newMissingColumn(name string, err error) *missingColumn {
+	column := &missingColumn{err: err}
+	column.ColumnExpressionImpl = NewColumnImpl(name, "", column)
+
+	return column
+}
+
+func (m *missingColumn) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	panic(m.err.Error())
+}
+
+func (m *missingColumn) serializeForProjection(statement StatementType, out *SQLBuilder) {
+	m.serialize(statement, out)
+}
+
+func (m *missingColumn) serializeForOrderBy(statement StatementType, out *SQLBuilder) {
+	m.serialize(statement, out)
+}
+
 func (t *tableImpl) Alias() string {
 	return t.alias
 }
 
+func (t *tableImpl) QualifiedName(dialect Dialect) string {
+	out := &SQLBuilder{Dialect: dialect}
+
+	if len(t.schemaName) > 0 {
+		out.WriteIdentifier(t.schemaName, true)
+		out.WriteString(".")
+	}
+
+	out.WriteIdentifier(t.name, true)
+
+	return out.Buff.String()
+}
+
 func (t *tableImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
 	if t == nil {
 		panic("jet: tableImpl is nil")
 	}
 
+	if t.inheritance == inheritanceOnly {
+		out.WriteString("ONLY")
+	}
+
 	// Use default schema if the schema name is not set
 	if len(t.schemaName) > 0 {
-		out.WriteIdentifier(t.schemaName)
+		schemaName := t.schemaName
+
+		if len(out.schema) > 0 {
+			schemaName = out.schema
+		}
+
+		out.WriteIdentifier(schemaName)
 		out.WriteString(".")
 	}
 
 	out.WriteIdentifier(t.name)
 
+	if t.inheritance == inheritanceDescendants {
+		out.WriteString("*")
+	}
+
 	if len(t.alias) > 0 {
 		out.WriteString("AS")
 		out.WriteIdentifier(t.alias)
 	}
+
+	for _, hint := range t.indexHints {
+		out.WriteString(string(hint.hintType))
+		out.WriteString("(")
+
+		for i, index := range hint.indexes {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+			out.WriteIdentifier(index)
+		}
+
+		out.WriteString(")")
+	}
+
+	out.columnScope.addTable(tableNameOrAlias(t))
 }
 
 // JoinType is type of table join
@@ -99,14 +293,17 @@ const (
 	RightJoin
 	FullJoin
 	CrossJoin
+	NaturalJoin
+	UsingJoin
 )
 
 // Join expressions are pseudo readable tables.
 type joinTableImpl struct {
-	lhs         Serializer
-	rhs         Serializer
-	joinType    JoinType
-	onCondition BoolExpression
+	lhs          Serializer
+	rhs          Serializer
+	joinType     JoinType
+	onCondition  BoolExpression
+	usingColumns []Column
 }
 
 // JoinTable interface
@@ -125,6 +322,21 @@ func NewJoinTable(lhs Serializer, rhs Serializer, joinType JoinType, onCondition
 	return &joinTable
 }
 
+// NewJoinTableUsing creates new join table, which joins lhs and rhs on equality of columns with the same name in both
+// tables: lhs JOIN rhs USING (column1, column2). Panics if columns list is empty.
+func NewJoinTableUsing(lhs Serializer, rhs Serializer, columns []Column) JoinTable {
+	if len(columns) == 0 {
+		panic("jet: join USING requires at least one column")
+	}
+
+	return &joinTableImpl{
+		lhs:          lhs,
+		rhs:          rhs,
+		joinType:     UsingJoin,
+		usingColumns: columns,
+	}
+}
+
 func (t *joinTableImpl) SchemaName() string {
 	if table, ok := t.lhs.(Table); ok {
 		return table.SchemaName()
@@ -136,6 +348,10 @@ func (t *joinTableImpl) TableName() string {
 	return ""
 }
 
+func (t *joinTableImpl) QualifiedName(dialect Dialect) string {
+	return ""
+}
+
 func (t *joinTableImpl) columns() []Column {
 	var ret []Column
 
@@ -177,6 +393,10 @@ func (t *joinTableImpl) serialize(statement StatementType, out *SQLBuilder, opti
 		out.WriteString("FULL JOIN")
 	case CrossJoin:
 		out.WriteString("CROSS JOIN")
+	case NaturalJoin:
+		out.WriteString("NATURAL JOIN")
+	case UsingJoin:
+		out.WriteString("JOIN")
 	}
 
 	if utils.IsNil(t.rhs) {
@@ -185,7 +405,19 @@ func (t *joinTableImpl) serialize(statement StatementType, out *SQLBuilder, opti
 
 	t.rhs.serialize(statement, out)
 
-	if t.onCondition == nil && t.joinType != CrossJoin {
+	if t.joinType == UsingJoin {
+		out.WriteString("USING (")
+		for i, column := range t.usingColumns {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+			out.WriteIdentifier(column.Name())
+		}
+		out.WriteString(")")
+		return
+	}
+
+	if t.onCondition == nil && t.joinType != CrossJoin && t.joinType != NaturalJoin {
 		panic("jet: join condition is nil")
 	}
 
@@ -194,3 +426,65 @@ func (t *joinTableImpl) serialize(statement StatementType, out *SQLBuilder, opti
 		t.onCondition.serialize(statement, out)
 	}
 }
+
+// ProjectionSets is a registry of named column lists defined on a table. Named projection sets allow projection
+// lists, for instance lightweight list projection and detailed projection, to be defined once and reused across
+// queries. Zero value is ready to use, nil ProjectionSets panics on use.
+type ProjectionSets struct {
+	lock sync.RWMutex
+	sets map[string]ColumnList
+}
+
+// Projection defines projection set name of table columns, if columns are passed, or returns previously defined
+// projection set name otherwise. Projection sets are defined per table instance, aliased table copies have to
+// define their own projection sets.
+func (p *ProjectionSets) Projection(table Table, name string, columns ...Column) ColumnList {
+	if p == nil {
+		panic("jet: projection sets can be defined only on tables")
+	}
+
+	if len(columns) == 0 {
+		p.lock.RLock()
+		defer p.lock.RUnlock()
+
+		projectionSet, ok := p.sets[name]
+
+		if !ok {
+			panic(fmt.Sprintf("jet: projection set '%s' is not defined for table '%s'", name, tableNameOrAlias(table)))
+		}
+
+		return projectionSet
+	}
+
+	var projectionSet ColumnList
+
+	for _, column := range UnwidColumnList(columns) {
+		columnExpression, ok := column.(ColumnExpression)
+
+		if !ok || (len(table.TableName()) > 0 && column.TableName() != tableNameOrAlias(table)) {
+			panic(fmt.Sprintf("jet: column '%s' of projection set '%s' is not a column of table '%s'",
+				column.Name(), name, tableNameOrAlias(table)))
+		}
+
+		projectionSet = append(projectionSet, columnExpression)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.sets == nil {
+		p.sets = map[string]ColumnList{}
+	}
+
+	p.sets[name] = projectionSet
+
+	return projectionSet
+}
+
+func tableNameOrAlias(table Table) string {
+	if len(table.Alias()) > 0 {
+		return table.Alias()
+	}
+
+	return table.TableName()
+}
@@ -15,6 +15,60 @@ func TestNewTable(t *testing.T) {
 	require.Equal(t, newTable.columns()[0].Name(), "intCol")
 }
 
+func TestTableQualifiedName(t *testing.T) {
+	require.Equal(t, `"schema"."table"`, NewTable("schema", "table", "alias").QualifiedName(defaultDialect))
+	require.Equal(t, `"Table"`, NewTable("", "Table", "").QualifiedName(defaultDialect))
+}
+
+func TestLookupColumn(t *testing.T) {
+	intCol := IntegerColumn("intCol")
+	generatedCol := StringColumn("generatedCol")
+	generatedCol.setGenerated(true)
+	table := NewTable("schema", "table", "alias", intCol, generatedCol)
+
+	column, err := LookupColumn(table, "generatedCol")
+	require.NoError(t, err)
+	require.Equal(t, generatedCol, column)
+	require.True(t, column.IsGenerated())
+
+	_, ok := TableColumn(table, "intCol").(ColumnInteger)
+	require.True(t, ok)
+
+	_, err = LookupColumn(table, "missingCol")
+	require.EqualError(t, err, "jet: column 'missingCol' not found in table 'alias'")
+
+	assertClauseSerializeErr(t, TableColumn(table, "missingCol").IS_NULL(),
+		"jet: column 'missingCol' not found in table 'alias'")
+}
+
+func TestTableInheritance(t *testing.T) {
+	table := NewTable("schema", "table", "alias", IntegerColumn("intCol"))
+
+	assertClauseSerialize(t, OnlyTable(table), `ONLY schema.table AS alias`)
+	assertClauseSerialize(t, TableWithDescendants(table), `schema.table * AS alias`)
+	assertClauseSerialize(t, table, `schema.table AS alias`)
+
+	joinTable := NewJoinTable(table, NewTable("schema", "table2", ""), CrossJoin, nil)
+	require.PanicsWithValue(t, "jet: ONLY and * modifiers are supported only for tables", func() {
+		OnlyTable(joinTable)
+	})
+}
+
+func TestTableWithIndexHint(t *testing.T) {
+	table := NewTable("schema", "table", "alias", IntegerColumn("intCol"))
+
+	assertClauseSerialize(t, TableWithIndexHint(TableWithIndexHint(table, UseIndex, "idx1", "idx2"), IgnoreIndex, "idx3"),
+		`schema.table AS alias USE INDEX (idx1, idx2) IGNORE INDEX (idx3)`)
+	assertClauseSerialize(t, table, `schema.table AS alias`)
+
+	require.PanicsWithValue(t, "jet: FORCE INDEX hint requires at least one index name", func() {
+		TableWithIndexHint(table, ForceIndex)
+	})
+	require.PanicsWithValue(t, "jet: index hints are supported only for tables", func() {
+		TableWithIndexHint(NewJoinTable(table, NewTable("schema", "table2", ""), CrossJoin, nil), UseIndex, "idx1")
+	})
+}
+
 func TestNewJoinTable(t *testing.T) {
 	newTable1 := NewTable("schema", "table", "", IntegerColumn("intCol1"))
 	newTable2 := NewTable("schema", "table2", "", IntegerColumn("intCol2"))
@@ -26,8 +80,37 @@ INNER JOIN schema.table2 ON ("intCol1" = "intCol2")`)
 
 	require.Equal(t, joinTable.SchemaName(), "schema")
 	require.Equal(t, joinTable.TableName(), "")
+	require.Equal(t, joinTable.QualifiedName(defaultDialect), "")
 
 	require.Equal(t, len(joinTable.columns()), 2)
 	require.Equal(t, joinTable.columns()[0].Name(), "intCol1")
 	require.Equal(t, joinTable.columns()[1].Name(), "intCol2")
 }
+
+func TestNewJoinTableUsing(t *testing.T) {
+	newTable1 := NewTable("schema", "table", "", IntegerColumn("id"), IntegerColumn("intCol1"))
+	newTable2 := NewTable("schema", "table2", "", IntegerColumn("id"), IntegerColumn("intCol2"))
+	newTable3 := NewTable("schema", "table3", "", IntegerColumn("intCol2"))
+
+	joinTable := NewJoinTableUsing(newTable1, newTable2, []Column{IntegerColumn("id")})
+
+	assertClauseSerialize(t, joinTable, `schema.table
+JOIN schema.table2 USING (id)`)
+
+	joinTable = NewJoinTableUsing(joinTable, newTable3, []Column{IntegerColumn("intCol2"), IntegerColumn("id")})
+
+	assertClauseSerialize(t, joinTable, `schema.table
+JOIN schema.table2 USING (id)
+JOIN schema.table3 USING ("intCol2", id)`)
+
+	require.Len(t, joinTable.columns(), 5)
+	require.Equal(t, "intCol1", joinTable.columns()[1].Name())
+	require.Equal(t, "intCol2", joinTable.columns()[4].Name())
+
+	require.PanicsWithValue(t, "jet: join USING requires at least one column", func() {
+		NewJoinTableUsing(newTable1, newTable2, nil)
+	})
+
+	assertClauseSerialize(t, NewJoinTable(newTable1, newTable2, NaturalJoin, nil), `schema.table
+NATURAL JOIN schema.table2`)
+}
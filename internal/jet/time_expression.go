@@ -85,6 +85,10 @@ func newTimeExpressionWrap(expression Expression) TimeExpression {
 	return &timeExpressionWrap
 }
 
+func (t *timeExpressionWrapper) unwrap() Serializer {
+	return t.Expression
+}
+
 // TimeExp is time expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as time expression.
 // Does not add sql cast to generated sql builder output.
@@ -18,6 +18,8 @@ type TimestampExpression interface {
 
 	ADD(rhs Interval) TimestampExpression
 	SUB(rhs Interval) TimestampExpression
+
+	AT_TIME_ZONE(timezone StringExpression) TimestampExpression
 }
 
 type timestampInterfaceImpl struct {
@@ -72,6 +74,10 @@ func (t *timestampInterfaceImpl) SUB(rhs Interval) TimestampExpression {
 	return TimestampExp(Sub(t.parent, rhs))
 }
 
+func (t *timestampInterfaceImpl) AT_TIME_ZONE(timezone StringExpression) TimestampExpression {
+	return TimestampExp(NewBinaryOperatorExpression(t.parent, timezone, AtTimeZoneOperator))
+}
+
 //-------------------------------------------------
 
 type timestampExpressionWrapper struct {
@@ -85,6 +91,10 @@ func newTimestampExpressionWrap(expression Expression) TimestampExpression {
 	return &timestampExpressionWrap
 }
 
+func (t *timestampExpressionWrapper) unwrap() Serializer {
+	return t.Expression
+}
+
 // TimestampExp is timestamp expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as timestamp expression.
 // Does not add sql cast to generated sql builder output.
@@ -61,3 +61,10 @@ func TestTimestampArithmetic(t *testing.T) {
 	assertClauseDebugSerialize(t, table1ColTimestamp.SUB(NewInterval(String("1 HOUR"))).EQ(timestamp),
 		"((table1.col_timestamp - INTERVAL '1 HOUR') = '2000-01-01 00:00:00')")
 }
+
+func TestTimestampAT_TIME_ZONE(t *testing.T) {
+	assertClauseSerialize(t, table1ColTimestamp.AT_TIME_ZONE(String("America/New_York")),
+		"(table1.col_timestamp AT TIME ZONE $1)", "America/New_York")
+	assertClauseSerialize(t, table1ColTimestamp.AT_TIME_ZONE(table2ColStr).LT(timestamp),
+		"((table1.col_timestamp AT TIME ZONE table2.col_str) < $1)", "2000-01-31 10:20:00.003")
+}
@@ -18,6 +18,8 @@ type TimestampzExpression interface {
 
 	ADD(rhs Interval) TimestampzExpression
 	SUB(rhs Interval) TimestampzExpression
+
+	AT_TIME_ZONE(timezone StringExpression) TimestampExpression
 }
 
 type timestampzInterfaceImpl struct {
@@ -72,6 +74,10 @@ func (t *timestampzInterfaceImpl) SUB(rhs Interval) TimestampzExpression {
 	return TimestampzExp(Sub(t.parent, rhs))
 }
 
+func (t *timestampzInterfaceImpl) AT_TIME_ZONE(timezone StringExpression) TimestampExpression {
+	return TimestampExp(NewBinaryOperatorExpression(t.parent, timezone, AtTimeZoneOperator))
+}
+
 //-------------------------------------------------
 
 type timestampzExpressionWrapper struct {
@@ -85,6 +91,10 @@ func newTimestampzExpressionWrap(expression Expression) TimestampzExpression {
 	return &timestampzExpressionWrap
 }
 
+func (t *timestampzExpressionWrapper) unwrap() Serializer {
+	return t.Expression
+}
+
 // TimestampzExp is timestamp with time zone expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as timestamp with time zone expression.
 // Does not add sql cast to generated sql builder output.
@@ -61,3 +61,8 @@ func TestTimestampzArithmetic(t *testing.T) {
 	assertClauseDebugSerialize(t, table1ColTimestampz.SUB(NewInterval(String("1 HOUR"))).EQ(timestampz),
 		"((table1.col_timestampz - INTERVAL '1 HOUR') = '2000-01-01 00:00:00.0000001 UTC')")
 }
+
+func TestTimestampzAT_TIME_ZONE(t *testing.T) {
+	assertClauseSerialize(t, table1ColTimestampz.AT_TIME_ZONE(String("UTC")),
+		"(table1.col_timestampz AT TIME ZONE $1)", "UTC")
+}
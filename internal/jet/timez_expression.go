@@ -85,6 +85,10 @@ func newTimezExpressionWrap(expression Expression) TimezExpression {
 	return &timezExpressionWrap
 }
 
+func (t *timezExpressionWrapper) unwrap() Serializer {
+	return t.Expression
+}
+
 // TimezExp is time with time zone expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as time with time zone expression.
 // Does not add sql cast to generated sql builder output.
@@ -1,6 +1,8 @@
 package jet
 
 import (
+	"errors"
+	"fmt"
 	"github.com/go-jet/jet/v2/internal/utils"
 	"reflect"
 	"strings"
@@ -53,6 +55,7 @@ func SerializeProjectionList(statement StatementType, projections []Projection,
 		}
 
 		col.serializeForProjection(statement, out)
+		out.parameterName = ""
 	}
 }
 
@@ -154,9 +157,7 @@ func UnwindRowFromModel(columns []Column, data interface{}) []Serializer {
 
 	for _, column := range columns {
 		columnName := column.Name()
-		structFieldName := utils.ToGoIdentifier(columnName)
-
-		structField := structValue.FieldByName(structFieldName)
+		structField := modelFieldForColumn(structValue, columnName)
 
 		if !structField.IsValid() {
 			panic("missing struct field for column : " + columnName)
@@ -176,6 +177,176 @@ func UnwindRowFromModel(columns []Column, data interface{}) []Serializer {
 	return row
 }
 
+// ColumnsOf returns projection list of table columns matching model struct fields. Field is matched with column the
+// same way query result mapping matches fields, by the name derived from column name or by the 'alias' tag.
+// Model can also be a slice of structs, the same as query destination. Every exported model field has to have
+// a matching table column, or ColumnsOf panics.
+func ColumnsOf(table Table, model interface{}) ProjectionList {
+	if table == nil {
+		panic("jet: table is nil")
+	}
+
+	structType := reflect.TypeOf(model)
+
+	for structType != nil && (structType.Kind() == reflect.Ptr || structType.Kind() == reflect.Slice) {
+		structType = structType.Elem()
+	}
+
+	if structType == nil || structType.Kind() != reflect.Struct {
+		panic("jet: model has to be a struct, a pointer to struct or a slice of structs")
+	}
+
+	return columnsOfStruct(table, structType)
+}
+
+// ErrUnknownColumn is returned by ColumnsByName when table does not have a column with requested name
+var ErrUnknownColumn = errors.New("unknown column")
+
+// ColumnsByName returns list of table columns with columnNames, in the same order. Names are matched exactly with
+// table column names, and ErrUnknownColumn is returned for any name table does not have. ColumnsByName can be used to
+// safely build dynamic projection lists from data driven column names, instead of raw string building.
+func ColumnsByName(table Table, columnNames ...string) (ColumnList, error) {
+	if table == nil {
+		return nil, errors.New("jet: table is nil")
+	}
+
+	tableColumns := map[string]ColumnExpression{}
+
+	for _, column := range table.columns() {
+		if columnExpression, ok := column.(ColumnExpression); ok {
+			tableColumns[column.Name()] = columnExpression
+		}
+	}
+
+	var ret ColumnList
+
+	for _, columnName := range columnNames {
+		column, ok := tableColumns[columnName]
+
+		if !ok {
+			return nil, fmt.Errorf("jet: table '%s' has no column '%s', %w", table.TableName(), columnName, ErrUnknownColumn)
+		}
+
+		ret = append(ret, column)
+	}
+
+	return ret, nil
+}
+
+func columnsOfStruct(table Table, structType reflect.Type) ProjectionList {
+	var ret ProjectionList
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			ret = append(ret, columnsOfStruct(table, field.Type)...)
+			continue
+		}
+
+		if field.PkgPath != "" { // unexported field
+			continue
+		}
+
+		column := tableColumnForField(table, field)
+
+		if column == nil {
+			panic(fmt.Sprintf("jet: struct field '%s' has no matching column in table '%s'", field.Name, table.TableName()))
+		}
+
+		ret = append(ret, column)
+	}
+
+	return ret
+}
+
+func tableColumnForField(table Table, field reflect.StructField) Projection {
+	tableName, columnName := fieldColumnName(field)
+
+	if tableName != "" && tableName != toCommonIdentifier(table.TableName()) && tableName != toCommonIdentifier(table.Alias()) {
+		return nil
+	}
+
+	for _, column := range table.columns() {
+		if toCommonIdentifier(column.Name()) == columnName {
+			if projection, ok := column.(Projection); ok {
+				return projection
+			}
+		}
+	}
+
+	return nil
+}
+
+// columnForField returns column from the column list matching struct field, or nil if there is no such column
+func columnForField(columns []ColumnExpression, field reflect.StructField) ColumnExpression {
+	tableName, columnName := fieldColumnName(field)
+
+	for _, column := range columns {
+		if tableName != "" && tableName != toCommonIdentifier(column.TableName()) {
+			continue
+		}
+
+		if toCommonIdentifier(column.Name()) == columnName {
+			return column
+		}
+	}
+
+	return nil
+}
+
+// fieldColumnName returns common identifiers of the table and column name struct field is mapped to. Column name is
+// field name, or the column part of the 'alias' tag. Table name is set only if 'alias' tag is qualified.
+func fieldColumnName(field reflect.StructField) (tableName, columnName string) {
+	columnName = field.Name
+
+	if aliasTag := field.Tag.Get("alias"); aliasTag != "" {
+		aliasParts := strings.Split(aliasTag, ".")
+		columnName = aliasParts[len(aliasParts)-1]
+
+		if len(aliasParts) > 1 {
+			tableName = toCommonIdentifier(aliasParts[0])
+		}
+	}
+
+	return tableName, toCommonIdentifier(columnName)
+}
+
+// modelFieldForColumn returns struct field for column name. Field is matched by the name derived from column name,
+// or by the column part of the 'alias' tag, the same way query result mapping matches fields.
+func modelFieldForColumn(structValue reflect.Value, columnName string) reflect.Value {
+	structField := structValue.FieldByName(utils.ToGoIdentifier(columnName))
+
+	if structField.IsValid() {
+		return structField
+	}
+
+	structType := structValue.Type()
+	commonColumnName := toCommonIdentifier(columnName)
+
+	for i := 0; i < structType.NumField(); i++ {
+		aliasTag := structType.Field(i).Tag.Get("alias")
+
+		if aliasTag == "" {
+			continue
+		}
+
+		aliasParts := strings.Split(aliasTag, ".")
+
+		if toCommonIdentifier(aliasParts[len(aliasParts)-1]) == commonColumnName {
+			return structValue.Field(i)
+		}
+	}
+
+	return reflect.Value{}
+}
+
+var identifierReplacer = strings.NewReplacer(" ", "", "-", "", "_", "")
+
+func toCommonIdentifier(name string) string {
+	return strings.ToLower(identifierReplacer.Replace(name))
+}
+
 // UnwindRowsFromModels func
 func UnwindRowsFromModels(columns []Column, data interface{}) [][]Serializer {
 	sliceValue := reflect.Indirect(reflect.ValueOf(data))
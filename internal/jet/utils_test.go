@@ -1,6 +1,7 @@
 package jet
 
 import (
+	"errors"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -17,3 +18,54 @@ func TestOptionalOrDefaultExpression(t *testing.T) {
 	require.Equal(t, OptionalOrDefaultExpression(defaultExpression), defaultExpression)
 	require.Equal(t, OptionalOrDefaultExpression(defaultExpression, optionalExpression), optionalExpression)
 }
+
+func TestColumnsOf(t *testing.T) {
+	type Embedded struct {
+		ColBool bool
+	}
+
+	type Table1Model struct {
+		Col1  int
+		Float float64 `alias:"table1.col_float"`
+		Embedded
+		internal string
+	}
+
+	require.Equal(t, ProjectionList{table1Col1, table1ColFloat, table1ColBool}, ColumnsOf(table1, &Table1Model{}))
+	require.Equal(t, ProjectionList{table1Col1, table1ColFloat, table1ColBool}, ColumnsOf(table1, Table1Model{}))
+
+	require.PanicsWithValue(t, "jet: struct field 'ColStr' has no matching column in table 'table1'", func() {
+		ColumnsOf(table1, &struct{ ColStr string }{})
+	})
+	require.PanicsWithValue(t, "jet: struct field 'ColInt' has no matching column in table 'table1'", func() {
+		ColumnsOf(table1, &struct {
+			ColInt int `alias:"table2.col_int"`
+		}{})
+	})
+	require.PanicsWithValue(t, "jet: model has to be a struct, a pointer to struct or a slice of structs", func() {
+		ColumnsOf(table1, []int{})
+	})
+	require.PanicsWithValue(t, "jet: table is nil", func() {
+		ColumnsOf(nil, &Table1Model{})
+	})
+}
+
+func TestColumnsByName(t *testing.T) {
+	columns, err := ColumnsByName(table1, "col_float", "col1")
+	require.NoError(t, err)
+	require.Equal(t, ColumnList{table1ColFloat, table1Col1}, columns)
+
+	columns, err = ColumnsByName(table1)
+	require.NoError(t, err)
+	require.Empty(t, columns)
+
+	_, err = ColumnsByName(table1, "col1", "col1; DROP TABLE table1")
+	require.True(t, errors.Is(err, ErrUnknownColumn))
+	require.EqualError(t, err, "jet: table 'table1' has no column 'col1; DROP TABLE table1', unknown column")
+
+	_, err = ColumnsByName(table1, "COL1")
+	require.True(t, errors.Is(err, ErrUnknownColumn))
+
+	_, err = ColumnsByName(nil, "col1")
+	require.EqualError(t, err, "jet: table is nil")
+}
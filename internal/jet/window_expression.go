@@ -2,6 +2,7 @@ package jet
 
 type commonWindowImpl struct {
 	expression Expression
+	filter     BoolExpression
 	window     Window
 }
 
@@ -14,7 +15,27 @@ func (w *commonWindowImpl) over(window ...Window) {
 }
 
 func (w *commonWindowImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if out.projectionScan != nil {
+		if w.window != nil {
+			out.projectionScan.window = true
+		} else {
+			out.projectionScan.aggregate = true
+		}
+	}
+
 	w.expression.serialize(statement, out)
+
+	// FILTER clause has to precede OVER clause
+	if w.filter != nil {
+		if serializeOverride := out.Dialect.OperatorSerializeOverride("FILTER"); serializeOverride != nil {
+			serializeOverride(w.filter)(statement, out, FallTrough(options)...)
+		} else {
+			out.WriteString("FILTER (WHERE")
+			w.filter.serialize(statement, out, NoWrap.WithFallTrough(options)...)
+			out.WriteString(")")
+		}
+	}
+
 	if w.window != nil {
 		out.WriteString("OVER")
 		w.window.serialize(statement, out, FallTrough(options)...)
@@ -25,6 +46,7 @@ func (w *commonWindowImpl) serialize(statement StatementType, out *SQLBuilder, o
 
 type windowExpression interface {
 	Expression
+	FILTER(condition BoolExpression) windowExpression
 	OVER(window ...Window) Expression
 }
 
@@ -43,6 +65,11 @@ type windowExpressionImpl struct {
 	commonWindowImpl
 }
 
+func (f *windowExpressionImpl) FILTER(condition BoolExpression) windowExpression {
+	f.commonWindowImpl.filter = condition
+	return f
+}
+
 func (f *windowExpressionImpl) OVER(window ...Window) Expression {
 	f.commonWindowImpl.over(window...)
 	return f
@@ -56,6 +83,7 @@ func (f *windowExpressionImpl) serialize(statement StatementType, out *SQLBuilde
 
 type floatWindowExpression interface {
 	FloatExpression
+	FILTER(condition BoolExpression) floatWindowExpression
 	OVER(window ...Window) FloatExpression
 }
 
@@ -74,6 +102,11 @@ type floatWindowExpressionImpl struct {
 	commonWindowImpl
 }
 
+func (f *floatWindowExpressionImpl) FILTER(condition BoolExpression) floatWindowExpression {
+	f.commonWindowImpl.filter = condition
+	return f
+}
+
 func (f *floatWindowExpressionImpl) OVER(window ...Window) FloatExpression {
 	f.commonWindowImpl.over(window...)
 	return f
@@ -87,6 +120,7 @@ func (f *floatWindowExpressionImpl) serialize(statement StatementType, out *SQLB
 
 type integerWindowExpression interface {
 	IntegerExpression
+	FILTER(condition BoolExpression) integerWindowExpression
 	OVER(window ...Window) IntegerExpression
 }
 
@@ -105,6 +139,11 @@ type integerWindowExpressionImpl struct {
 	commonWindowImpl
 }
 
+func (f *integerWindowExpressionImpl) FILTER(condition BoolExpression) integerWindowExpression {
+	f.commonWindowImpl.filter = condition
+	return f
+}
+
 func (f *integerWindowExpressionImpl) OVER(window ...Window) IntegerExpression {
 	f.commonWindowImpl.over(window...)
 	return f
@@ -118,6 +157,7 @@ func (f *integerWindowExpressionImpl) serialize(statement StatementType, out *SQ
 
 type boolWindowExpression interface {
 	BoolExpression
+	FILTER(condition BoolExpression) boolWindowExpression
 	OVER(window ...Window) BoolExpression
 }
 
@@ -136,6 +176,11 @@ type boolWindowExpressionImpl struct {
 	commonWindowImpl
 }
 
+func (f *boolWindowExpressionImpl) FILTER(condition BoolExpression) boolWindowExpression {
+	f.commonWindowImpl.filter = condition
+	return f
+}
+
 func (f *boolWindowExpressionImpl) OVER(window ...Window) BoolExpression {
 	f.commonWindowImpl.over(window...)
 	return f
@@ -7,6 +7,7 @@ type Window interface {
 	ROWS(start FrameExtent, end ...FrameExtent) Window
 	RANGE(start FrameExtent, end ...FrameExtent) Window
 	GROUPS(start FrameExtent, end ...FrameExtent) Window
+	EXCLUDE(exclusion FrameExclusion) Window
 }
 
 type windowImpl struct {
@@ -14,6 +15,7 @@ type windowImpl struct {
 	orderBy     ClauseOrderBy
 	frameUnits  string
 	start, end  FrameExtent
+	exclusion   FrameExclusion
 
 	parent Window
 }
@@ -55,6 +57,15 @@ func (w *windowImpl) serialize(statement StatementType, out *SQLBuilder, options
 		}
 	}
 
+	if w.exclusion != nil {
+		if serializeOverride := out.Dialect.OperatorSerializeOverride("EXCLUDE"); serializeOverride != nil {
+			serializeOverride(w.exclusion)(statement, out, FallTrough(options)...)
+		} else {
+			out.WriteString("EXCLUDE")
+			w.exclusion.serialize(statement, out, FallTrough(options)...)
+		}
+	}
+
 	if !contains(options, NoWrap) {
 		out.WriteByte(')')
 	}
@@ -83,6 +94,11 @@ func (w *windowImpl) GROUPS(start FrameExtent, end ...FrameExtent) Window {
 	return w.parent
 }
 
+func (w *windowImpl) EXCLUDE(exclusion FrameExclusion) Window {
+	w.exclusion = exclusion
+	return w.parent
+}
+
 func (w *windowImpl) setFrameRange(start FrameExtent, end ...FrameExtent) {
 	w.start = start
 	if len(end) > 0 {
@@ -160,7 +176,29 @@ type frameExtentKeyword struct {
 	Keyword
 }
 
-func (f frameExtentKeyword) isFrameExtent() {}
+func (f frameExtentKeyword) isFrameExtent()    {}
+func (f frameExtentKeyword) isFrameExclusion() {}
+
+// -----------------------------------------------
+
+// FrameExclusion interface
+type FrameExclusion interface {
+	Serializer
+	isFrameExclusion()
+}
+
+// Window frame exclusion keywords
+var (
+	GROUP     = frameExclusionKeyword{"GROUP"}
+	TIES      = frameExclusionKeyword{"TIES"}
+	NO_OTHERS = frameExclusionKeyword{"NO OTHERS"}
+)
+
+type frameExclusionKeyword struct {
+	Keyword
+}
+
+func (f frameExclusionKeyword) isFrameExclusion() {}
 
 // -----------------------------------------------
 
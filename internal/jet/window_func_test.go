@@ -7,6 +7,16 @@ func TestFrameExtent(t *testing.T) {
 	assertClauseSerialize(t, FOLLOWING(Int(4)), "$1 FOLLOWING", int64(4))
 }
 
+func TestAggregateFilter(t *testing.T) {
+	assertClauseSerialize(t, COUNT_STAR().FILTER(table1ColBool), "COUNT(*) FILTER (WHERE table1.col_bool)")
+	assertClauseSerialize(t, SUMf(table1ColFloat).FILTER(table1ColInt.GT(Int(2))).OVER(PARTITION_BY(table1Col3)),
+		"SUM(table1.col_float) FILTER (WHERE table1.col_int > $1) OVER (PARTITION BY table1.col3)", int64(2))
+	assertClauseSerialize(t, MAXf(table1ColFloat).FILTER(table1ColBool.IS_TRUE()).OVER(),
+		"MAX(table1.col_float) FILTER (WHERE table1.col_bool IS TRUE) OVER ()")
+	assertClauseSerialize(t, BOOL_AND(table1ColBool).FILTER(table1ColInt.IS_NOT_NULL()),
+		"BOOL_AND(table1.col_bool) FILTER (WHERE table1.col_int IS NOT NULL)")
+}
+
 func TestWindowFunctions(t *testing.T) {
 	assertClauseSerialize(t, PARTITION_BY(table1Col1), "(PARTITION BY table1.col1)")
 	assertClauseSerialize(t, PARTITION_BY(table1Col3).ORDER_BY(table1Col1), "(PARTITION BY table1.col3 ORDER BY table1.col1)")
@@ -18,4 +28,12 @@ func TestWindowFunctions(t *testing.T) {
 		"(ORDER BY table1.col1 RANGE BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING)")
 	assertClauseSerialize(t, ORDER_BY(table1Col1).RANGE(PRECEDING(UNBOUNDED), CURRENT_ROW),
 		"(ORDER BY table1.col1 RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)")
+	assertClauseSerialize(t, ORDER_BY(table1Col1).ROWS(PRECEDING(Int(1)), FOLLOWING(Int(1))).EXCLUDE(CURRENT_ROW),
+		"(ORDER BY table1.col1 ROWS BETWEEN $1 PRECEDING AND $2 FOLLOWING EXCLUDE CURRENT ROW)", int64(1), int64(1))
+	assertClauseSerialize(t, ORDER_BY(table1Col1).GROUPS(PRECEDING(UNBOUNDED), CURRENT_ROW).EXCLUDE(TIES),
+		"(ORDER BY table1.col1 GROUPS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW EXCLUDE TIES)")
+	assertClauseSerialize(t, ORDER_BY(table1Col1).RANGE(PRECEDING(UNBOUNDED)).EXCLUDE(GROUP),
+		"(ORDER BY table1.col1 RANGE UNBOUNDED PRECEDING EXCLUDE GROUP)")
+	assertClauseSerialize(t, ORDER_BY(table1Col1).RANGE(PRECEDING(UNBOUNDED)).EXCLUDE(NO_OTHERS),
+		"(ORDER BY table1.col1 RANGE UNBOUNDED PRECEDING EXCLUDE NO OTHERS)")
 }
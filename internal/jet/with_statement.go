@@ -35,11 +35,13 @@ func (w withImpl) serialize(statement StatementType, out *SQLBuilder, options ..
 	out.NewLine()
 	out.WriteString("WITH")
 
-	if w.recursive {
+	recursive := w.recursive || hasSelfReference(w.dialect, w.ctes)
+
+	if recursive {
 		out.WriteString("RECURSIVE")
 	}
 
-	for i, cte := range w.ctes {
+	for i, cte := range orderCTEsByReferences(w.dialect, recursive, w.ctes) {
 		if i > 0 {
 			out.WriteString(",")
 		}
@@ -49,6 +51,78 @@ func (w withImpl) serialize(statement StatementType, out *SQLBuilder, options ..
 	w.primaryStatement.serialize(statement, out, NoWrap.WithFallTrough(options)...)
 }
 
+// orderCTEsByReferences returns list of ctes, in which every cte is placed after the ctes it references.
+// Otherwise, the order in which ctes are listed is preserved. References between recursive ctes can be circular,
+// in which case the remaining ctes are left in the listed order.
+func orderCTEsByReferences(dialect Dialect, recursive bool, ctes []*CommonTableExpression) []*CommonTableExpression {
+	cteNames := map[string]bool{}
+
+	for _, cte := range ctes {
+		cteNames[cte.alias] = true
+	}
+
+	references := make([]map[string]bool, len(ctes))
+
+	for i, cte := range ctes {
+		references[i] = cte.references(dialect)
+
+		if recursive {
+			delete(references[i], cte.alias)
+		}
+	}
+
+	var ret []*CommonTableExpression
+	placed := make([]bool, len(ctes))
+	placedNames := map[string]bool{}
+
+	for len(ret) < len(ctes) {
+		next := -1
+
+		for i := range ctes {
+			if !placed[i] && referencesPlaced(references[i], cteNames, placedNames) {
+				next = i
+				break
+			}
+		}
+
+		if next == -1 { // circular references
+			for i := range ctes {
+				if !placed[i] {
+					next = i
+					break
+				}
+			}
+		}
+
+		placed[next] = true
+		placedNames[ctes[next].alias] = true
+		ret = append(ret, ctes[next])
+	}
+
+	return ret
+}
+
+// hasSelfReference returns true if any of the ctes references itself, in which case WITH has to be RECURSIVE
+func hasSelfReference(dialect Dialect, ctes []*CommonTableExpression) bool {
+	for _, cte := range ctes {
+		if cte.references(dialect)[cte.alias] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func referencesPlaced(references, cteNames, placedNames map[string]bool) bool {
+	for name := range references {
+		if cteNames[name] && !placedNames[name] {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (w withImpl) projections() ProjectionList {
 	return ProjectionList{}
 }
@@ -57,6 +131,7 @@ func (w withImpl) projections() ProjectionList {
 type CommonTableExpression struct {
 	selectTableImpl
 
+	Materialized    bool
 	NotMaterialized bool
 	Columns         []ColumnExpression
 }
@@ -85,7 +160,9 @@ func (c CommonTableExpression) serialize(statement StatementType, out *SQLBuilde
 		}
 		out.WriteString("AS")
 
-		if c.NotMaterialized {
+		if c.Materialized {
+			out.WriteString("MATERIALIZED")
+		} else if c.NotMaterialized {
 			out.WriteString("NOT MATERIALIZED")
 		}
 
@@ -96,10 +173,26 @@ func (c CommonTableExpression) serialize(statement StatementType, out *SQLBuilde
 		c.Statement.serialize(statement, out, FallTrough(options)...)
 
 	} else { // serialize CTE in FROM clause
+		if out.cteReferences != nil {
+			out.cteReferences[c.alias] = true
+		}
+		out.columnScope.addTable(c.alias)
+
 		out.WriteIdentifier(c.alias)
 	}
 }
 
+// references returns names of the ctes referenced from the CTE query
+func (c CommonTableExpression) references(dialect Dialect) map[string]bool {
+	out := &SQLBuilder{Dialect: dialect, cteReferences: map[string]bool{}}
+
+	if c.Statement != nil {
+		c.Statement.serialize(WithStatementType, out)
+	}
+
+	return out.cteReferences
+}
+
 // AllColumns returns list of all projections in the CTE
 func (c CommonTableExpression) AllColumns() ProjectionList {
 	if len(c.Columns) > 0 {
@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseInterval parses PostgreSQL interval text output(default 'postgres' IntervalStyle), for instance
+// '1 year 2 mons -3 days 04:05:06.789', into months, days and microseconds components.
+func ParseInterval(text string) (months, days int32, microseconds int64, err error) {
+	fields := strings.Fields(text)
+
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+
+		if strings.Contains(field, ":") {
+			timeMicroseconds, err := parseIntervalTime(field)
+
+			if err != nil {
+				return 0, 0, 0, fmt.Errorf("invalid interval '%s', %w", text, err)
+			}
+
+			microseconds += timeMicroseconds
+			continue
+		}
+
+		quantity, err := strconv.ParseInt(field, 10, 32)
+
+		if err != nil || i+1 >= len(fields) {
+			return 0, 0, 0, fmt.Errorf("invalid interval '%s'", text)
+		}
+
+		i++
+
+		switch strings.TrimSuffix(fields[i], "s") {
+		case "year":
+			months += int32(quantity) * 12
+		case "mon":
+			months += int32(quantity)
+		case "day":
+			days += int32(quantity)
+		default:
+			return 0, 0, 0, fmt.Errorf("invalid interval '%s', unsupported unit '%s'", text, fields[i])
+		}
+	}
+
+	return months, days, microseconds, nil
+}
+
+// parseIntervalTime parses interval time part, for instance '-04:05:06.789', into microseconds
+func parseIntervalTime(text string) (int64, error) {
+	sign := int64(1)
+
+	if strings.HasPrefix(text, "-") {
+		sign = -1
+		text = text[1:]
+	} else {
+		text = strings.TrimPrefix(text, "+")
+	}
+
+	parts := strings.Split(text, ":")
+
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid time '%s'", text)
+	}
+
+	hours, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	minutes, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	secondsAndFraction := strings.SplitN(parts[2], ".", 2)
+
+	seconds, err := strconv.ParseInt(secondsAndFraction[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	var fraction int64
+
+	if len(secondsAndFraction) == 2 {
+		fractionText := secondsAndFraction[1]
+
+		if len(fractionText) > 6 {
+			return 0, fmt.Errorf("invalid time fraction '%s'", fractionText)
+		}
+
+		fraction, err = strconv.ParseInt(fractionText+strings.Repeat("0", 6-len(fractionText)), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return sign * (((hours*60+minutes)*60+seconds)*1000000 + fraction), nil
+}
@@ -48,3 +48,26 @@ func TestErrorCatchNonErr(t *testing.T) {
 
 	require.Error(t, err, "11")
 }
+
+func TestParseInterval(t *testing.T) {
+	testInterval := func(text string, expectedMonths, expectedDays int32, expectedMicroseconds int64) {
+		months, days, microseconds, err := ParseInterval(text)
+		require.NoError(t, err)
+		require.Equal(t, expectedMonths, months)
+		require.Equal(t, expectedDays, days)
+		require.Equal(t, expectedMicroseconds, microseconds)
+	}
+
+	testInterval("00:00:00", 0, 0, 0)
+	testInterval("3 days", 0, 3, 0)
+	testInterval("1 year 2 mons -3 days 04:05:06.789", 14, -3, 14706789000)
+	testInterval("1 mon 1 day -00:00:00.000001", 1, 1, -1)
+	testInterval("-2 years 100:00:00", -24, 0, 360000000000)
+
+	_, _, _, err := ParseInterval("P1Y2M")
+	require.EqualError(t, err, "invalid interval 'P1Y2M'")
+	_, _, _, err = ParseInterval("2 weeks")
+	require.EqualError(t, err, "invalid interval '2 weeks', unsupported unit 'weeks'")
+	_, _, _, err = ParseInterval("1 day 10:aa:00")
+	require.Error(t, err)
+}
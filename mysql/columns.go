@@ -8,6 +8,61 @@ type Column = jet.ColumnExpression
 // ColumnList function returns list of columns that be used as projection or column list for UPDATE and INSERT statement.
 type ColumnList = jet.ColumnList
 
+// ColumnsOf returns projection list of table columns matching model struct fields, so that projection list can
+// not drift out of sync with query result destination. Panics if some of the model fields has no matching column.
+var ColumnsOf = jet.ColumnsOf
+
+// ColumnsByName returns list of table columns with column names, or an error wrapping ErrUnknownColumn if table
+// does not have a column with some of the names. Can be used to build dynamic projection lists safely.
+var ColumnsByName = jet.ColumnsByName
+
+// ErrUnknownColumn is returned by ColumnsByName when table does not have a column with requested name
+var ErrUnknownColumn = jet.ErrUnknownColumn
+
+// SetGenerated marks column as generated (GENERATED ALWAYS identity or computed column).
+// Values can not be assigned to generated columns in INSERT and UPDATE statements.
+var SetGenerated = jet.SetGenerated
+
+// SetHasDefault marks column as having database default value. Nil pointer model values of such columns can be
+// omitted from INSERT statement with ColumnList.ExceptZeroDefaults.
+var SetHasDefault = jet.SetHasDefault
+
+// NewExpressionColumn creates new named column, whose value is computed by expression.
+var NewExpressionColumn = jet.NewExpressionColumn
+
+// ExcludedBool creates reference to the bool column value proposed for insertion, serialized as VALUES(column),
+// or as new.column if inserted rows have row alias(see InsertStatement.AS_NEW). It can be used only in
+// ON DUPLICATE KEY UPDATE clause.
+var ExcludedBool = jet.ExcludedBool
+
+// ExcludedInteger creates reference to the integer column value proposed for insertion.
+// It can be used only in ON DUPLICATE KEY UPDATE clause.
+var ExcludedInteger = jet.ExcludedInteger
+
+// ExcludedFloat creates reference to the float column value proposed for insertion.
+// It can be used only in ON DUPLICATE KEY UPDATE clause.
+var ExcludedFloat = jet.ExcludedFloat
+
+// ExcludedString creates reference to the string column value proposed for insertion.
+// It can be used only in ON DUPLICATE KEY UPDATE clause.
+var ExcludedString = jet.ExcludedString
+
+// ExcludedTime creates reference to the time column value proposed for insertion.
+// It can be used only in ON DUPLICATE KEY UPDATE clause.
+var ExcludedTime = jet.ExcludedTime
+
+// ExcludedDate creates reference to the date column value proposed for insertion.
+// It can be used only in ON DUPLICATE KEY UPDATE clause.
+var ExcludedDate = jet.ExcludedDate
+
+// ExcludedDateTime creates reference to the datetime column value proposed for insertion.
+// It can be used only in ON DUPLICATE KEY UPDATE clause.
+var ExcludedDateTime = jet.ExcludedTimestamp
+
+// ExcludedTimestamp creates reference to the timestamp column value proposed for insertion.
+// It can be used only in ON DUPLICATE KEY UPDATE clause.
+var ExcludedTimestamp = jet.ExcludedTimestamp
+
 // ColumnBool is interface for SQL boolean columns.
 type ColumnBool = jet.ColumnBool
 
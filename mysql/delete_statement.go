@@ -8,6 +8,9 @@ type DeleteStatement interface {
 
 	USING(tables ...ReadableTable) DeleteStatement
 	WHERE(expression BoolExpression) DeleteStatement
+	// AllRows explicitly allows statement without WHERE clause, which deletes all table rows. Statement without
+	// WHERE clause, that is not marked with AllRows, can not be serialized or executed.
+	AllRows() DeleteStatement
 	ORDER_BY(orderByClauses ...OrderByClause) DeleteStatement
 	LIMIT(limit int64) DeleteStatement
 }
@@ -50,6 +53,11 @@ func (d *deleteStatementImpl) WHERE(expression BoolExpression) DeleteStatement {
 	return d
 }
 
+func (d *deleteStatementImpl) AllRows() DeleteStatement {
+	d.Where.Mandatory = false
+	return d
+}
+
 func (d *deleteStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) DeleteStatement {
 	d.OrderBy.List = orderByClauses
 	return d
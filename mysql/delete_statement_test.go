@@ -9,6 +9,13 @@ func TestDeleteUnconditionally(t *testing.T) {
 	assertStatementSqlErr(t, table1.DELETE().WHERE(nil), `jet: WHERE clause not set`)
 }
 
+func TestDeleteAllRows(t *testing.T) {
+	assertStatementSql(t, table1.DELETE().AllRows().LIMIT(10), `
+DELETE FROM db.table1
+LIMIT ?;
+`, int64(10))
+}
+
 func TestDeleteWithWhere(t *testing.T) {
 	assertStatementSql(t, table1.DELETE().WHERE(table1Col1.EQ(Int(1))), `
 DELETE FROM db.table1
@@ -1,7 +1,11 @@
 package mysql
 
 import (
+	"fmt"
 	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/google/uuid"
+	"strings"
+	"time"
 )
 
 // Dialect is implementation of MySQL dialect for SQL Builder serialisation.
@@ -16,22 +20,127 @@ func newDialect() jet.Dialect {
 	operatorSerializeOverrides["/"] = mysqlDivision
 	operatorSerializeOverrides["#"] = mysqlBitXor
 	operatorSerializeOverrides[jet.StringConcatOperator] = mysqlCONCAToperator
+	operatorSerializeOverrides["NULLS FIRST"] = mysqlNULLSFIRST
+	operatorSerializeOverrides["NULLS LAST"] = mysqlNULLSLAST
+	operatorSerializeOverrides["EXCLUDE"] = mysqlEXCLUDE
+	operatorSerializeOverrides["FILTER"] = mysqlFILTER
+	operatorSerializeOverrides[jet.AtTimeZoneOperator] = mysqlCONVERTTZ
+	operatorSerializeOverrides["LIMIT ALL"] = mysqlLIMITALL
+	operatorSerializeOverrides["IN"] = mysqlINSubQuery("IN")
+	operatorSerializeOverrides["NOT IN"] = mysqlINSubQuery("NOT IN")
+	operatorSerializeOverrides["EXCLUDED"] = mysqlEXCLUDED
+
+	functionSerializeOverrides := map[string]jet.SerializeOverride{}
+	functionSerializeOverrides["BOOL_AND"] = mysqlBoolAggregate("BOOL_AND", "MIN")
+	functionSerializeOverrides["BOOL_OR"] = mysqlBoolAggregate("BOOL_OR", "MAX")
 
 	mySQLDialectParams := jet.DialectParams{
 		Name:                       "MySQL",
 		PackageName:                "mysql",
 		OperatorSerializeOverrides: operatorSerializeOverrides,
+		FunctionSerializeOverrides: functionSerializeOverrides,
 		AliasQuoteChar:             '"',
 		IdentifierQuoteChar:        '`',
 		ArgumentPlaceholder: func(int) string {
 			return "?"
 		},
-		ReservedWords: reservedWords,
+		ArgumentToString: mysqlArgumentToString,
+		ReservedWords:    reservedWords,
+		MaxParameters:    65535,
 	}
 
 	return jet.NewDialect(mySQLDialectParams)
 }
 
+func mysqlArgumentToString(value interface{}) (string, bool) {
+	switch bindValue := value.(type) {
+	case bool:
+		if bindValue {
+			return "1", true
+		}
+		return "0", true
+	case string:
+		return mysqlStringQuote(bindValue), true
+	case []byte:
+		return mysqlStringQuote(string(bindValue)), true
+	case time.Time, uuid.UUID:
+		return "", false
+	case fmt.Stringer:
+		return mysqlStringQuote(bindValue.String()), true
+	}
+
+	return "", false
+}
+
+var mysqlStringQuoteReplacer = strings.NewReplacer(`\`, `\\`, `'`, `''`, "\x00", `\0`)
+
+// mysqlStringQuote escapes backslashes in addition to quotes, because MySQL treats backslash as escape character
+func mysqlStringQuote(value string) string {
+	return `'` + mysqlStringQuoteReplacer.Replace(value) + `'`
+}
+
+func mysqlNULLSFIRST(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 1 {
+			panic("jet: invalid number of expressions for NULLS FIRST")
+		}
+
+		jet.Serialize(expressions[0], statement, out, jet.NoWrap)
+		out.WriteString("IS NOT NULL")
+	}
+}
+
+func mysqlNULLSLAST(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 1 {
+			panic("jet: invalid number of expressions for NULLS LAST")
+		}
+
+		jet.Serialize(expressions[0], statement, out, jet.NoWrap)
+		out.WriteString("IS NULL")
+	}
+}
+
+func mysqlEXCLUDE(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		panic("jet: MySQL does not support window frame EXCLUDE clause")
+	}
+}
+
+// mysqlBoolAggregate emulates boolean aggregate function, because MySQL booleans are integers: (MIN(expression) = 1)
+func mysqlBoolAggregate(name, aggregate string) jet.SerializeOverride {
+	return func(expressions ...jet.Serializer) jet.SerializerFunc {
+		return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+			if len(expressions) != 1 {
+				panic("jet: invalid number of expressions for " + name)
+			}
+
+			out.WriteString("(" + aggregate + "(")
+			jet.Serialize(expressions[0], statement, out, jet.NoWrap)
+			out.WriteString(") = 1)")
+		}
+	}
+}
+
+// mysqlEXCLUDED references column value proposed for insertion in ON DUPLICATE KEY UPDATE clause
+func mysqlEXCLUDED(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 1 {
+			panic("jet: invalid number of expressions for EXCLUDED")
+		}
+
+		out.WriteString("VALUES(")
+		jet.Serialize(expressions[0], statement, out, jet.ShortName)
+		out.WriteString(")")
+	}
+}
+
+func mysqlFILTER(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		panic("jet: MySQL does not support aggregate FILTER clause")
+	}
+}
+
 func mysqlBitXor(expressions ...jet.Serializer) jet.SerializerFunc {
 	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
 		if len(expressions) < 2 {
@@ -66,6 +175,73 @@ func mysqlCONCAToperator(expressions ...jet.Serializer) jet.SerializerFunc {
 	}
 }
 
+// mysqlCONVERTTZ treats timestamp as a value in the session time zone and converts it to the given time zone
+func mysqlCONVERTTZ(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 2 {
+			panic("jet: invalid number of expressions for operator AT TIME ZONE")
+		}
+		out.WriteString("CONVERT_TZ(")
+
+		jet.Serialize(expressions[0], statement, out, options...)
+
+		out.WriteString(", @@session.time_zone, ")
+
+		jet.Serialize(expressions[1], statement, out, options...)
+
+		out.WriteString(")")
+	}
+}
+
+// mysqlLIMITALL emulates LIMIT ALL with the largest possible row count, as recommended by MySQL documentation
+func mysqlLIMITALL(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		out.WriteString("LIMIT 18446744073709551615")
+	}
+}
+
+// limitedSubQuery is sub-query which result is limited with LIMIT clause
+type limitedSubQuery interface {
+	hasLimit() bool
+}
+
+// mysqlINSubQuery wraps sub-query with LIMIT clause into derived table, because MySQL does not support LIMIT
+// in IN/NOT IN sub-queries: IN (SELECT * FROM (SELECT ... LIMIT n) AS limited_sub_query)
+func mysqlINSubQuery(operator string) func(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(expressions ...jet.Serializer) jet.SerializerFunc {
+		return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+			if len(expressions) < 2 {
+				panic("jet: invalid number of expressions for operator " + operator)
+			}
+
+			lhs := expressions[0]
+			rhs := expressions[1]
+
+			jet.Serialize(lhs, statement, out, jet.FallTrough(options)...)
+			out.WriteString(operator)
+
+			subQuery, ok := rhs.(limitedSubQuery)
+
+			if !ok || !subQuery.hasLimit() {
+				jet.Serialize(rhs, statement, out, jet.FallTrough(options)...)
+				return
+			}
+
+			out.WriteString("(")
+			out.IncreaseIdent()
+			out.NewLine()
+			out.WriteString("SELECT *")
+			out.NewLine()
+			out.WriteString("FROM")
+			jet.Serialize(rhs, statement, out, jet.FallTrough(options)...)
+			out.WriteString("AS limited_sub_query")
+			out.DecreaseIdent()
+			out.NewLine()
+			out.WriteString(")")
+		}
+	}
+}
+
 func mysqlDivision(expressions ...jet.Serializer) jet.SerializerFunc {
 	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
 		if len(expressions) < 2 {
@@ -47,6 +47,11 @@ func TestExists(t *testing.T) {
 ))`, int64(1))
 }
 
+func TestString_CONTAINS_operator(t *testing.T) {
+	assertSerialize(t, table3StrCol.CONTAINS(`50%_off`), `(table3.col2 LIKE ? ESCAPE '\\')`, `%50\%\_off%`)
+	assertDebugSerialize(t, table3StrCol.CONTAINS("50%"), `(table3.col2 LIKE '%50\\%%' ESCAPE '\\')`)
+}
+
 func TestString_REGEXP_LIKE_operator(t *testing.T) {
 	assertSerialize(t, table3StrCol.REGEXP_LIKE(table2ColStr), "(table3.col2 REGEXP table2.col_str)")
 	assertSerialize(t, table3StrCol.REGEXP_LIKE(String("JOHN")), "(table3.col2 REGEXP ?)", "JOHN")
@@ -60,3 +65,14 @@ func TestString_NOT_REGEXP_LIKE_operator(t *testing.T) {
 	assertSerialize(t, table3StrCol.NOT_REGEXP_LIKE(String("JOHN"), false), "(table3.col2 NOT REGEXP ?)", "JOHN")
 	assertSerialize(t, table3StrCol.NOT_REGEXP_LIKE(String("JOHN"), true), "(table3.col2 NOT REGEXP BINARY ?)", "JOHN")
 }
+
+type testStringer string
+
+func (s testStringer) String() string {
+	return string(s)
+}
+
+func TestArgumentToString(t *testing.T) {
+	assertDebugSerialize(t, String(`\' OR 1=1 --`), `'\\'' OR 1=1 --'`)
+	assertDebugSerialize(t, Raw("#1", map[string]interface{}{"#1": testStringer(`\' OR 1=1 --`)}), `('\\'' OR 1=1 --')`)
+}
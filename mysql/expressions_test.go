@@ -60,3 +60,52 @@ func TestRawType(t *testing.T) {
 	assertSerialize(t, RawDate("table.colDate").EQ(DateT(time)),
 		"((table.colDate) = CAST(? AS DATE))", time)
 }
+
+func TestTimestampAT_TIME_ZONE(t *testing.T) {
+	assertSerialize(t, table1ColTimestamp.AT_TIME_ZONE(String("America/New_York")),
+		"(CONVERT_TZ(table1.col_timestamp, @@session.time_zone, ?))", "America/New_York")
+	assertDebugSerialize(t, table1ColTimestamp.AT_TIME_ZONE(String("+02:00")).GT(table2ColTimestamp),
+		"((CONVERT_TZ(table1.col_timestamp, @@session.time_zone, '+02:00')) > table2.col_timestamp)")
+}
+
+func TestIN_SELECT(t *testing.T) {
+	assertSerialize(t, table1ColString.IN_SELECT(SELECT(table2ColStr).FROM(table2)), `(table1.col_string IN (
+     SELECT table2.col_str AS "table2.col_str"
+     FROM db.table2
+))`)
+	// MySQL does not support LIMIT in IN sub-queries, limited sub-query is wrapped into derived table
+	assertSerialize(t, table1ColString.IN_SELECT(SELECT(table2ColStr).FROM(table2).LIMIT(5)), `(table1.col_string IN (
+     SELECT *
+     FROM (
+          SELECT table2.col_str AS "table2.col_str"
+          FROM db.table2
+          LIMIT ?
+     ) AS limited_sub_query
+))`, int64(5))
+	assertSerialize(t, table1ColInt.NOT_IN_SELECT(UNION(SELECT(table2ColInt).FROM(table2), SELECT(table3ColInt).FROM(table3)).LIMIT(10)), `(table1.col_int NOT IN (
+     SELECT *
+     FROM (
+          (
+               SELECT table2.col_int AS "table2.col_int"
+               FROM db.table2
+          )
+          UNION
+          (
+               SELECT table3.col_int AS "table3.col_int"
+               FROM db.table3
+          )
+          LIMIT ?
+     ) AS limited_sub_query
+))`, int64(10))
+	assertSerialize(t, table1ColInt.IN(Int(1), Int(2)), "(table1.col_int IN (?, ?))", int64(1), int64(2))
+	assertPanicErr(t, func() {
+		table1ColString.NOT_IN_SELECT(SELECT(table2ColInt).FROM(table2))
+	}, "jet: NOT_IN_SELECT sub-query projects integer column, but string expression is expected")
+}
+
+func TestInformationFunctions(t *testing.T) {
+	assertSerialize(t, CURRENT_USER(), "CURRENT_USER")
+	assertSerialize(t, SESSION_USER(), "SESSION_USER()")
+	assertSerialize(t, CURRENT_SCHEMA(), "DATABASE()")
+	assertSerialize(t, table1ColString.EQ(CURRENT_USER()), "(table1.col_string = CURRENT_USER)")
+}
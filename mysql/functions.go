@@ -73,9 +73,24 @@ var BIT_AND = jet.BIT_AND
 // BIT_OR is aggregate function used to calculates the bitwise OR of all non-null input values, or null if none.
 var BIT_OR = jet.BIT_OR
 
+// BOOL_AND is aggregate function. Returns true if all input values are true, otherwise false.
+// MySQL does not have boolean aggregates, and BOOL_AND is emulated with (MIN(boolExpression) = 1).
+func BOOL_AND(boolExpression BoolExpression) BoolExpression {
+	return jet.BOOL_AND(boolExpression)
+}
+
+// BOOL_OR is aggregate function. Returns true if at least one input value is true, otherwise false.
+// MySQL does not have boolean aggregates, and BOOL_OR is emulated with (MAX(boolExpression) = 1).
+func BOOL_OR(boolExpression BoolExpression) BoolExpression {
+	return jet.BOOL_OR(boolExpression)
+}
+
 // COUNT is aggregate function. Returns number of input rows for which the value of expression is not null.
 var COUNT = jet.COUNT
 
+// COUNT_STAR is aggregate function. Returns number of all input rows, COUNT(*).
+var COUNT_STAR = jet.COUNT_STAR
+
 // MAX is aggregate function. Returns maximum value of expression across all input values
 var MAX = jet.MAX
 
@@ -169,6 +184,10 @@ var LTRIM = jet.LTRIM
 // from characters (a space by default) from the end of string
 var RTRIM = jet.RTRIM
 
+// EscapeLike escapes LIKE wildcards('%' and '_') and escape character in value, so value can be matched literally
+// inside pattern with ESCAPE '\\' clause. StringExpression CONTAINS escapes text the same way.
+var EscapeLike = jet.EscapeLike
+
 // CONCAT adds two or more expressions together
 var CONCAT = jet.CONCAT
 
@@ -255,6 +274,21 @@ func UNIX_TIMESTAMP(str StringExpression) TimestampExpression {
 	return jet.NewTimestampFunc("UNIX_TIMESTAMP", str)
 }
 
+//----------------- Information Functions ------------//
+
+// CURRENT_USER returns user name and host name of the MySQL account that the server used to authenticate the current client
+var CURRENT_USER = jet.CURRENT_USER
+
+// SESSION_USER returns user name and host name provided by the client
+func SESSION_USER() StringExpression {
+	return jet.NewStringFunc("SESSION_USER")
+}
+
+// CURRENT_SCHEMA returns name of the default (current) database. It is serialized as MySQL DATABASE() function.
+func CURRENT_SCHEMA() StringExpression {
+	return jet.NewStringFunc("DATABASE")
+}
+
 //----------- Comparison operators ---------------//
 
 // EXISTS checks for existence of the rows in subQuery
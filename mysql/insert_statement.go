@@ -14,6 +14,15 @@ type InsertStatement interface {
 	MODELS(data interface{}) InsertStatement
 
 	ON_DUPLICATE_KEY_UPDATE(assigments ...ColumnAssigment) InsertStatement
+	// AS_NEW assigns row alias 'new' to the inserted rows, so that Excluded columns in ON DUPLICATE KEY UPDATE clause
+	// are serialized as new.column instead of VALUES(column), deprecated since MySQL 8.0.20. Row alias requires
+	// MySQL 8.0.19 or later, and can not be used with QUERY.
+	AS_NEW() InsertStatement
+	// IGNORE creates INSERT IGNORE statement, which skips rows conflicting with existing rows instead of returning an error
+	IGNORE() InsertStatement
+	// IgnoreDuplicatesOnly skips rows conflicting with existing rows on primary or unique key, using no-op update of
+	// the first insert column: ON DUPLICATE KEY UPDATE col = col. Unlike IGNORE, all other errors are still returned.
+	IgnoreDuplicatesOnly() InsertStatement
 
 	QUERY(selectStatement SelectStatement) InsertStatement
 }
@@ -24,7 +33,7 @@ func newInsertStatement(table Table, columns []jet.Column) InsertStatement {
 		&newInsert.Insert, &newInsert.ValuesQuery, &newInsert.OnDuplicateKey)
 
 	newInsert.Insert.Table = table
-	newInsert.Insert.Columns = columns
+	newInsert.Insert.SetColumns(columns)
 
 	return newInsert
 }
@@ -53,33 +62,67 @@ func (is *insertStatementImpl) MODELS(data interface{}) InsertStatement {
 }
 
 func (is *insertStatementImpl) ON_DUPLICATE_KEY_UPDATE(assigments ...ColumnAssigment) InsertStatement {
-	is.OnDuplicateKey = assigments
+	is.OnDuplicateKey.assigments = assigments
 	return is
 }
 
+func (is *insertStatementImpl) AS_NEW() InsertStatement {
+	is.OnDuplicateKey.rowAlias = "new"
+	return is
+}
+
+func (is *insertStatementImpl) IGNORE() InsertStatement {
+	is.Insert.Name = "INSERT IGNORE INTO"
+	return is
+}
+
+func (is *insertStatementImpl) IgnoreDuplicatesOnly() InsertStatement {
+	for _, column := range jet.UnwidColumnList(is.Insert.GetColumns()) {
+		columnExpression, ok := column.(jet.ColumnExpression)
+
+		if !ok || column.IsGenerated() {
+			continue
+		}
+
+		is.OnDuplicateKey.assigments = []jet.ColumnAssigment{jet.NewNoOpColumnAssigment(columnExpression)}
+		return is
+	}
+
+	panic("jet: IgnoreDuplicatesOnly requires at least one assignable insert column")
+}
+
 func (is *insertStatementImpl) QUERY(selectStatement SelectStatement) InsertStatement {
 	is.ValuesQuery.Query = selectStatement
 	return is
 }
 
-type onDuplicateKeyUpdateClause []jet.ColumnAssigment
+type onDuplicateKeyUpdateClause struct {
+	rowAlias   string
+	assigments []jet.ColumnAssigment
+}
 
 // Serialize for SetClause
 func (s onDuplicateKeyUpdateClause) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
-	if len(s) == 0 {
+	if len(s.assigments) == 0 {
 		return
 	}
+
+	if s.rowAlias != "" {
+		out.WriteString("AS")
+		out.WriteIdentifier(s.rowAlias)
+	}
+
 	out.NewLine()
 	out.WriteString("ON DUPLICATE KEY UPDATE")
 	out.IncreaseIdent(24)
 
-	for i, assigment := range s {
+	for i, assigment := range s.assigments {
 		if i > 0 {
 			out.WriteString(",")
 			out.NewLine()
 		}
 
-		jet.Serialize(assigment, statementType, out, jet.ShortName.WithFallTrough(options)...)
+		jet.SerializeConflictUpdate(assigment, s.rowAlias, statementType, out, jet.ShortName.WithFallTrough(options)...)
 	}
 
 	out.DecreaseIdent(24)
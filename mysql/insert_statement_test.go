@@ -179,3 +179,131 @@ ON DUPLICATE KEY UPDATE col_bool = ?,
 `, "two", true, int64(11), 11.1, "str", "11:23:11", "2020-01-22 03:04:05", "2020-12-01")
 	})
 }
+
+func TestInsertOnDuplicateKeyUpdateExcluded(t *testing.T) {
+	stmt := table1.INSERT(table1Col1, table1ColFloat).
+		VALUES(1, 2.5).
+		ON_DUPLICATE_KEY_UPDATE(
+			table1ColFloat.SET(table1ColFloat.ADD(ExcludedFloat(table1ColFloat))),
+		)
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float)
+VALUES (?, ?)
+ON DUPLICATE KEY UPDATE col_float = (col_float + VALUES(col_float));
+`, 1, 2.5)
+
+	assertStatementSql(t, table1.INSERT(table1Col1, table1ColFloat).
+		VALUES(1, 2.5).
+		AS_NEW().
+		ON_DUPLICATE_KEY_UPDATE(
+			table1ColFloat.SET(ExcludedFloat(table1ColFloat)),
+			table1ColBool.SET(ExcludedFloat(table1ColFloat).GT(Float(1))),
+		), `
+INSERT INTO db.table1 (col1, col_float)
+VALUES (?, ?) AS new
+ON DUPLICATE KEY UPDATE col_float = new.col_float,
+                        col_bool = (new.col_float > ?);
+`, 1, 2.5, 1.0)
+
+	assertPanicErr(t, func() {
+		SELECT(ExcludedFloat(table1ColFloat)).FROM(table1).Sql()
+	}, "jet: Excluded column can be used only in INSERT conflict update clause")
+}
+
+func TestInsertOnDuplicateKeyUpdateCorrelatedSubQuery(t *testing.T) {
+	stmt := table1.INSERT(table1Col1, table1ColInt).
+		VALUES(1, 2).
+		ON_DUPLICATE_KEY_UPDATE(
+			table1ColFloat.SET(FloatExp(SELECT(MAXf(table2ColFloat)).FROM(table2).WHERE(table2ColInt.EQ(table1ColInt)))),
+		)
+
+	// columns of correlated sub-query stay qualified, because both tables have col_int column
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_int)
+VALUES (?, ?)
+ON DUPLICATE KEY UPDATE col_float = (
+                             SELECT MAX(table2.col_float)
+                             FROM db.table2
+                             WHERE table2.col_int = table1.col_int
+                        );
+`, 1, 2)
+}
+
+func TestInsertIgnore(t *testing.T) {
+	stmt := table1.INSERT(table1Col1, table1ColFloat).
+		VALUES(1, "two").
+		IGNORE()
+
+	assertStatementSql(t, stmt, `
+INSERT IGNORE INTO db.table1 (col1, col_float)
+VALUES (?, ?);
+`, 1, "two")
+}
+
+func TestInsertIgnoreDuplicatesOnly(t *testing.T) {
+	stmt := table1.INSERT(table1Col1, table1ColFloat).
+		VALUES(1, 2.2).
+		IgnoreDuplicatesOnly()
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float)
+VALUES (?, ?)
+ON DUPLICATE KEY UPDATE col1 = col1;
+`, 1, 2.2)
+
+	idColumn := IntegerColumn("id")
+	computedColumn := StringColumn("computed")
+	SetGenerated(computedColumn)
+	computedTable := NewTable("db", "computed_table", "", computedColumn, idColumn)
+
+	assertStatementSql(t, computedTable.INSERT().VALUES(1).IgnoreDuplicatesOnly(), `
+INSERT INTO db.computed_table
+VALUES (?)
+ON DUPLICATE KEY UPDATE id = id;
+`, 1)
+	assertPanicErr(t, func() {
+		computedTable.INSERT(computedColumn).IgnoreDuplicatesOnly()
+	}, "jet: IgnoreDuplicatesOnly requires at least one assignable insert column")
+}
+
+func TestInsertGeneratedColumn(t *testing.T) {
+	idColumn := IntegerColumn("id")
+	computedColumn := StringColumn("computed")
+	SetGenerated(computedColumn)
+	computedTable := NewTable("db", "computed_table", "", idColumn, computedColumn)
+
+	assertStatementSqlErr(t, computedTable.INSERT(idColumn, computedColumn).VALUES(1, "one"),
+		"jet: can't assign value to generated column 'computed'")
+	assertStatementSqlErr(t, computedTable.INSERT(idColumn).VALUES(1).
+		ON_DUPLICATE_KEY_UPDATE(computedColumn.SET(String("two"))),
+		"jet: can't assign value to generated column 'computed'")
+}
+
+func TestInsertValuesFromModelExceptZeroDefaults(t *testing.T) {
+	var (
+		nameColumn   = StringColumn("name")
+		activeColumn = BoolColumn("active")
+		statusColumn = StringColumn("status")
+	)
+	SetHasDefault(activeColumn)
+	SetHasDefault(statusColumn)
+
+	jobs := NewTable("db", "jobs", "", nameColumn, activeColumn, statusColumn)
+
+	type Jobs struct {
+		Name   string
+		Active bool
+		Status *string
+	}
+
+	assertStatementSql(t, jobs.INSERT(ColumnList{nameColumn, activeColumn, statusColumn}.ExceptZeroDefaults(Jobs{})).MODEL(Jobs{}), `
+INSERT INTO db.jobs (name, active)
+VALUES (?, ?);
+`, "", false)
+
+	assertStatementSql(t, jobs.INSERT(ColumnList{statusColumn}.ExceptZeroDefaults(Jobs{})).MODEL(Jobs{}), `
+INSERT INTO db.jobs
+VALUES ();
+`)
+}
@@ -8,6 +8,9 @@ import (
 
 func TestBool(t *testing.T) {
 	assertSerialize(t, Bool(false), `?`, false)
+	assertDebugSerialize(t, Bool(false), `0`)
+	assertDebugSerialize(t, Bool(true), `1`)
+	assertDebugSerialize(t, table1ColBool.EQ(Bool(true)), `(table1.col_bool = 1)`)
 }
 
 func TestInt(t *testing.T) {
@@ -60,6 +63,7 @@ func TestFloat(t *testing.T) {
 
 func TestString(t *testing.T) {
 	assertSerialize(t, String("Some text"), `?`, "Some text")
+	assertDebugSerialize(t, String(`O'Reilly \' OR 1=1`), `'O''Reilly \\'' OR 1=1'`)
 }
 
 func TestDate(t *testing.T) {
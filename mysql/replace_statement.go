@@ -0,0 +1,57 @@
+package mysql
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// ReplaceStatement is interface for MySQL REPLACE statement. REPLACE works exactly like INSERT, except that
+// old row, with the same value of primary key or unique index as new row, is deleted before new row is inserted.
+type ReplaceStatement interface {
+	Statement
+
+	// Replace row of values
+	VALUES(value interface{}, values ...interface{}) ReplaceStatement
+	// Replace row of values, where value for each column is extracted from filed of structure data.
+	// If data is not struct or there is no field for every column selected, this method will panic.
+	MODEL(data interface{}) ReplaceStatement
+	MODELS(data interface{}) ReplaceStatement
+
+	QUERY(selectStatement SelectStatement) ReplaceStatement
+}
+
+func newReplaceStatement(table Table, columns []jet.Column) ReplaceStatement {
+	newReplace := &replaceStatementImpl{}
+	newReplace.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, newReplace,
+		&newReplace.Replace, &newReplace.ValuesQuery)
+
+	newReplace.Replace.Name = "REPLACE INTO"
+	newReplace.Replace.Table = table
+	newReplace.Replace.Columns = columns
+
+	return newReplace
+}
+
+type replaceStatementImpl struct {
+	jet.SerializerStatement
+
+	Replace     jet.ClauseInsert
+	ValuesQuery jet.ClauseValuesQuery
+}
+
+func (rs *replaceStatementImpl) VALUES(value interface{}, values ...interface{}) ReplaceStatement {
+	rs.ValuesQuery.Rows = append(rs.ValuesQuery.Rows, jet.UnwindRowFromValues(value, values))
+	return rs
+}
+
+func (rs *replaceStatementImpl) MODEL(data interface{}) ReplaceStatement {
+	rs.ValuesQuery.Rows = append(rs.ValuesQuery.Rows, jet.UnwindRowFromModel(rs.Replace.GetColumns(), data))
+	return rs
+}
+
+func (rs *replaceStatementImpl) MODELS(data interface{}) ReplaceStatement {
+	rs.ValuesQuery.Rows = append(rs.ValuesQuery.Rows, jet.UnwindRowsFromModels(rs.Replace.GetColumns(), data)...)
+	return rs
+}
+
+func (rs *replaceStatementImpl) QUERY(selectStatement SelectStatement) ReplaceStatement {
+	rs.ValuesQuery.Query = selectStatement
+	return rs
+}
@@ -0,0 +1,42 @@
+package mysql
+
+import (
+	"testing"
+)
+
+func TestReplaceValues(t *testing.T) {
+	assertStatementSql(t, table1.REPLACE(table1Col1, table1ColFloat).VALUES(1, 2.2).VALUES(11, 22.2), `
+REPLACE INTO db.table1 (col1, col_float)
+VALUES (?, ?),
+       (?, ?);
+`, 1, 2.2, 11, 22.2)
+}
+
+func TestReplaceModels(t *testing.T) {
+	type Table1Model struct {
+		Col1     int
+		ColFloat float64
+	}
+
+	stmt := table1.REPLACE(table1Col1, table1ColFloat).
+		MODEL(Table1Model{Col1: 1, ColFloat: 1.11}).
+		MODELS([]Table1Model{{Col1: 2, ColFloat: 2.22}})
+
+	assertStatementSql(t, stmt, `
+REPLACE INTO db.table1 (col1, col_float)
+VALUES (?, ?),
+       (?, ?);
+`, 1, 1.11, 2, 2.22)
+}
+
+func TestReplaceQuery(t *testing.T) {
+	stmt := table1.REPLACE(table1Col1).
+		QUERY(table2.SELECT(table2Col3))
+
+	assertStatementSql(t, stmt, `
+REPLACE INTO db.table1 (col1) (
+     SELECT table2.col3 AS "table2.col3"
+     FROM db.table2
+);
+`)
+}
@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"github.com/go-jet/jet/v2/internal/testutils"
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
@@ -98,6 +99,117 @@ ORDER BY table2.col_int DESC, table2.col_int ASC;
 `)
 }
 
+func TestSelectAppendHaving(t *testing.T) {
+	baseQuery := func() SelectStatement {
+		return SELECT(table2ColInt, COUNT(table2ColFloat)).FROM(table2).GROUP_BY(table2ColInt)
+	}
+
+	assertStatementSql(t, baseQuery().AppendHaving(COUNT(table2ColFloat).GT(Int(10))), `
+SELECT table2.col_int AS "table2.col_int",
+     COUNT(table2.col_float)
+FROM db.table2
+GROUP BY table2.col_int
+HAVING COUNT(table2.col_float) > ?;
+`, int64(10))
+	assertStatementSql(t, baseQuery().
+		HAVING(COUNT(table2ColFloat).GT(Int(10))).
+		AppendHaving(nil).
+		AppendHaving(MAXf(table2ColFloat).LT(Float(1.5))), `
+SELECT table2.col_int AS "table2.col_int",
+     COUNT(table2.col_float)
+FROM db.table2
+GROUP BY table2.col_int
+HAVING (COUNT(table2.col_float) > ?) AND (MAX(table2.col_float) < ?);
+`, int64(10), 1.5)
+}
+
+func TestSelectBoolAggregates(t *testing.T) {
+	stmt := SELECT(
+		table1ColInt,
+		BOOL_AND(table1ColBool).AS("all_active"),
+		BOOL_OR(table1ColFloat.GT(Float(10))).AS("any_large"),
+	).FROM(
+		table1,
+	).GROUP_BY(
+		table1ColInt,
+	).HAVING(
+		BOOL_OR(table1ColBool).IS_TRUE(),
+	)
+
+	assertStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int",
+     (MIN(table1.col_bool) = 1) AS "all_active",
+     (MAX(table1.col_float > ?) = 1) AS "any_large"
+FROM db.table1
+GROUP BY table1.col_int
+HAVING (MAX(table1.col_bool) = 1) IS TRUE;
+`, float64(10))
+}
+
+func TestSelectValidate(t *testing.T) {
+	require.NoError(t, SELECT(table1Col1, table2Col3).
+		FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))).
+		WHERE(table1ColBool.IS_TRUE()).
+		ORDER_BY(table2ColFloat).
+		Validate())
+
+	subQuery := SELECT(table3Col1).FROM(table3).AsTable("sub_query")
+	subQueryCol1 := table3Col1.From(subQuery)
+	require.NoError(t, SELECT(table1Col1, subQueryCol1).
+		FROM(table1.CROSS_JOIN(subQuery)).
+		WHERE(table1ColInt.IN(SELECT(table2ColInt).FROM(table2).WHERE(table2Col3.EQ(table1Col1)))).
+		Validate())
+
+	require.EqualError(t, SELECT(table1Col1).FROM(table1).WHERE(table2Col3.EQ(Int(1))).Validate(),
+		"jet: column 'table2.col3' references table 'table2', which is not in the FROM clause")
+	require.EqualError(t, SELECT(table1Col1).FROM(table1).ORDER_BY(subQueryCol1).Validate(),
+		"jet: column 'sub_query.table3.col1' references table 'sub_query', which is not in the FROM clause")
+	require.EqualError(t, SELECT(table3Col1).Validate(),
+		"jet: column 'table3.col1' references table 'table3', which is not in the FROM clause")
+	require.EqualError(t, SELECT(table1Col1, table2Col3).
+		FROM(table1).
+		WHERE(EXISTS(SELECT(Int(1)).FROM(table2))).
+		Validate(),
+		"jet: column 'table2.col3' references table 'table2', which is not in the FROM clause")
+}
+
+func TestSelectAppendOrderBy(t *testing.T) {
+	baseQuery := func() SelectStatement {
+		return SELECT(table2ColFloat).FROM(table2).ORDER_BY(table2ColInt.DESC())
+	}
+
+	assertStatementSql(t, baseQuery().AppendOrderBy(table2ColFloat.ASC(), table2ColInt.ASC(), table2Col3), `
+SELECT table2.col_float AS "table2.col_float"
+FROM db.table2
+ORDER BY table2.col_int DESC, table2.col_float ASC, table2.col3;
+`)
+	assertStatementSql(t, baseQuery().AppendOrderBy(table2ColFloat.DESC()).AppendOrderBy(table2ColFloat, table2ColInt.ADD(Int(1)).ASC()), `
+SELECT table2.col_float AS "table2.col_float"
+FROM db.table2
+ORDER BY table2.col_int DESC, table2.col_float DESC, table2.col_int + ? ASC;
+`, int64(1))
+	assertStatementSql(t, SELECT(table2ColFloat).FROM(table2).AppendOrderBy(table2ColInt.DESC()), `
+SELECT table2.col_float AS "table2.col_float"
+FROM db.table2
+ORDER BY table2.col_int DESC;
+`)
+}
+
+func TestSelectOrderByNulls(t *testing.T) {
+	assertStatementSql(t, SELECT(table2ColFloat).FROM(table2).ORDER_BY(table2ColInt.DESC().NULLS_LAST(), table2ColFloat.NULLS_FIRST()), `
+SELECT table2.col_float AS "table2.col_float"
+FROM db.table2
+ORDER BY table2.col_int IS NULL, table2.col_int DESC, table2.col_float IS NOT NULL, table2.col_float ASC;
+`)
+	assertStatementSql(t, SELECT(table2ColFloat).FROM(table2).ORDER_BY(
+		NormalizeNullOrdering(table2ColInt.DESC(), table2ColFloat.ASC(), table2ColStr, table2ColBool.ASC().NULLS_FIRST())...,
+	), `
+SELECT table2.col_float AS "table2.col_float"
+FROM db.table2
+ORDER BY table2.col_int IS NOT NULL, table2.col_int DESC, table2.col_float IS NULL, table2.col_float ASC, table2.col_str IS NULL, table2.col_str ASC, table2.col_bool IS NOT NULL, table2.col_bool ASC;
+`)
+}
+
 func TestSelectLimitOffset(t *testing.T) {
 	assertStatementSql(t, SELECT(table2ColInt).FROM(table2).LIMIT(10), `
 SELECT table2.col_int AS "table2.col_int"
@@ -110,6 +222,18 @@ FROM db.table2
 LIMIT ?
 OFFSET ?;
 `, int64(10), int64(2))
+
+	assertStatementSql(t, SELECT(table2ColInt).FROM(table2).LIMIT_ALL().OFFSET(20), `
+SELECT table2.col_int AS "table2.col_int"
+FROM db.table2
+LIMIT 18446744073709551615
+OFFSET ?;
+`, int64(20))
+	assertStatementSql(t, SELECT(table2ColInt).FROM(table2).LIMIT_ALL().LIMIT(10), `
+SELECT table2.col_int AS "table2.col_int"
+FROM db.table2
+LIMIT ?;
+`, int64(10))
 }
 
 func TestSelectLock(t *testing.T) {
@@ -154,3 +278,162 @@ WHERE NOT (EXISTS (
       ));
 `)
 }
+
+func TestExistsCount(t *testing.T) {
+	assertStatementSql(t, ExistsCount(table1, table1ColInt.EQ(Int(11))), `
+SELECT (EXISTS (
+          SELECT 1
+          FROM db.table1
+          WHERE table1.col_int = ?
+     )) AS "exists";
+`, int64(11))
+}
+
+func TestSelectFilter(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		Filter(RANK().OVER(PARTITION_BY(table1ColInt).ORDER_BY(table1ColFloat)),
+			func(window Expression) BoolExpression {
+				return IntExp(window).EQ(Int(1))
+			})
+
+	assertStatementSql(t, stmt, `
+SELECT filtered.`+"`table1.col_int`"+` AS "table1.col_int"
+FROM (
+          SELECT table1.col_int AS "table1.col_int",
+               RANK() OVER (PARTITION BY table1.col_int ORDER BY table1.col_float) AS "filter_window"
+          FROM db.table1
+     ) AS filtered
+WHERE filtered.filter_window = ?;
+`, int64(1))
+}
+
+func TestSelectFilterOrderByLimit(t *testing.T) {
+	stmt := SELECT(table1ColInt, table1ColFloat).
+		FROM(table1).
+		ORDER_BY(table1ColInt, table1ColFloat.DESC()).
+		LIMIT(5).
+		OFFSET(10).
+		Filter(ROW_NUMBER().OVER(PARTITION_BY(table1ColInt).ORDER_BY(table1ColFloat.DESC())),
+			func(window Expression) BoolExpression {
+				return IntExp(window).EQ(Int(1))
+			})
+
+	assertStatementSql(t, stmt, `
+SELECT filtered.`+"`table1.col_int`"+` AS "table1.col_int",
+     filtered.`+"`table1.col_float`"+` AS "table1.col_float"
+FROM (
+          SELECT table1.col_int AS "table1.col_int",
+               table1.col_float AS "table1.col_float",
+               ROW_NUMBER() OVER (PARTITION BY table1.col_int ORDER BY table1.col_float DESC) AS "filter_window",
+               ROW_NUMBER() OVER (ORDER BY table1.col_int, table1.col_float DESC) AS "filter_order"
+          FROM db.table1
+     ) AS filtered
+WHERE filtered.filter_window = ?
+ORDER BY filter_order ASC
+LIMIT ?
+OFFSET ?;
+`, int64(1), int64(5), int64(10))
+}
+
+func TestSelectFilterDoesNotModifyStatement(t *testing.T) {
+	stmt := SELECT(table1ColInt).FROM(table1)
+	cumeDist := CUME_DIST().OVER(ORDER_BY(table1ColFloat))
+	topHalf := func(window Expression) BoolExpression {
+		return FloatExp(window).LT_EQ(Float(0.5))
+	}
+
+	expectedSQL := `
+SELECT filtered.` + "`table1.col_int`" + ` AS "table1.col_int"
+FROM (
+          SELECT table1.col_int AS "table1.col_int",
+               CUME_DIST() OVER (ORDER BY table1.col_float) AS "filter_window"
+          FROM db.table1
+     ) AS filtered
+WHERE filtered.filter_window <= ?;
+`
+	assertStatementSql(t, stmt.Filter(cumeDist, topHalf), expectedSQL, 0.5)
+	assertStatementSql(t, stmt.Filter(cumeDist, topHalf), expectedSQL, 0.5)
+	assertStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1;
+`)
+}
+
+func TestSelectWindowFrameExclusion(t *testing.T) {
+	stmt := SELECT(
+		AVG(table1ColFloat).OVER(ORDER_BY(table1ColInt).ROWS(PRECEDING(2), FOLLOWING(2)).EXCLUDE(CURRENT_ROW)),
+	).FROM(table1)
+
+	assertStatementSqlErr(t, stmt, "jet: MySQL does not support window frame EXCLUDE clause")
+}
+
+func TestSelectAggregateFilter(t *testing.T) {
+	stmt := SELECT(
+		SUMf(table1ColFloat).FILTER(table1ColBool.EQ(Bool(true))).OVER(PARTITION_BY(table1ColInt)),
+	).FROM(table1)
+
+	assertStatementSqlErr(t, stmt, "jet: MySQL does not support aggregate FILTER clause")
+}
+
+func TestSelectInlineParameters(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(table1ColString.EQ(String(`\' OR 1=1 -- `)).AND(table1ColBool.EQ(Bool(true))))
+
+	query, args := stmt.InlineParameters().Sql()
+
+	require.Equal(t, query, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE (table1.col_string = '\\'' OR 1=1 -- ') AND (table1.col_bool = 1);
+`)
+	require.Len(t, args, 0)
+}
+
+func TestScopedSelect(t *testing.T) {
+	assertStatementSql(t, ScopedSelect(table1, table1ColBool.IS_NOT_TRUE(), table1ColInt).WHERE(table1ColInt.EQ(Int(1))), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_bool IS NOT TRUE AND (table1.col_int = ?);
+`, int64(1))
+}
+
+func TestSelectAsCount(t *testing.T) {
+	query := SELECT(table1ColString, COUNT(STAR).AS("count")).
+		FROM(table1).
+		GROUP_BY(table1ColString).
+		HAVING(COUNT(STAR).GT(Int(1))).
+		ORDER_BY(table1ColString).
+		LIMIT(10)
+
+	assertStatementSql(t, query.AsCount(), `
+SELECT COUNT(*) AS "count"
+FROM (
+          SELECT table1.col_string AS "table1.col_string",
+               COUNT(*) AS "count"
+          FROM db.table1
+          GROUP BY table1.col_string
+          HAVING COUNT(*) > ?
+     ) AS t;
+`, int64(1))
+}
+
+func TestSelectOrderByAggregate(t *testing.T) {
+	count := COUNT(STAR)
+
+	// MySQL would treat double quoted alias in ORDER BY as string literal, so aggregate is repeated
+	assertStatementSql(t, SELECT(table1ColString, count.AS("count")).
+		FROM(table1).
+		GROUP_BY(table1ColString).
+		ORDER_BY(count.DESC()), `
+SELECT table1.col_string AS "table1.col_string",
+     COUNT(*) AS "count"
+FROM db.table1
+GROUP BY table1.col_string
+ORDER BY COUNT(*) DESC;
+`)
+
+	assertSerializeErr(t, SELECT(table1ColString, count.AS("count")).FROM(table1).ORDER_BY(count.DESC()),
+		"jet: ORDER BY aggregate requires GROUP BY clause, if SELECT clause has non-aggregate projections")
+}
@@ -80,6 +80,10 @@ func (s *setStatementImpl) LIMIT(limit int64) setStatement {
 	return s
 }
 
+func (s *setStatementImpl) hasLimit() bool {
+	return s.setOperator.Limit.Count >= 0
+}
+
 func (s *setStatementImpl) OFFSET(offset int64) setStatement {
 	s.setOperator.Offset.Count = offset
 	return s
@@ -0,0 +1,39 @@
+package mysql
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestStatementNamedSql(t *testing.T) {
+	query, args, err := SELECT(table1ColInt, Int(5).AS("five")).
+		FROM(table1).
+		WHERE(table1Col1.EQ(Int(1)).OR(table1Col1.EQ(Int(2))).AND(table1ColBool.IS_TRUE()).AND(Int(3).EQ(Int(4)))).
+		LIMIT(10).
+		NamedSql()
+
+	require.NoError(t, err)
+	require.Equal(t, `
+SELECT table1.col_int AS "table1.col_int",
+     :param_1 AS "five"
+FROM db.table1
+WHERE (((table1.col1 = :col1) OR (table1.col1 = :col1_2)) AND table1.col_bool IS TRUE) AND (:param_4 = :param_5)
+LIMIT :param_6;
+`, query)
+	require.Equal(t, map[string]interface{}{
+		"param_1": int64(5), "col1": int64(1), "col1_2": int64(2), "param_4": int64(3), "param_5": int64(4), "param_6": int64(10),
+	}, args)
+
+	query, args, err = table2.INSERT(table2ColInt, table2ColStr).
+		VALUES(1, "str").
+		VALUES(2, "str").
+		NamedSql()
+
+	require.NoError(t, err)
+	require.Equal(t, `
+INSERT INTO db.table2 (col_int, col_str)
+VALUES (:col_int, :col_str),
+       (:col_int_2, :col_str);
+`, query)
+	require.Equal(t, map[string]interface{}{"col_int": 1, "col_int_2": 2, "col_str": "str"}, args)
+}
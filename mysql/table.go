@@ -8,9 +8,24 @@ type Table interface {
 	readableTable
 
 	INSERT(columns ...jet.Column) InsertStatement
+	REPLACE(columns ...jet.Column) ReplaceStatement
 	UPDATE(columns ...jet.Column) UpdateStatement
 	DELETE() DeleteStatement
 	LOCK() LockStatement
+
+	indexHintTable
+
+	// Projection defines named projection set of table columns, if columns are passed, or returns previously defined
+	// projection set otherwise. Panics if projection set is not defined.
+	Projection(name string, columns ...jet.Column) ColumnList
+
+	// Column returns table column with the name, as it was declared in the table. If table does not have the column,
+	// statement using returned column panics when serialized.
+	Column(name string) Column
+	// ColumnOrError returns table column with the name, or an error if table does not have the column.
+	ColumnOrError(name string) (Column, error)
+	// Projections returns list of all the table columns, in the order they are declared in the table
+	Projections() ProjectionList
 }
 
 type readableTable interface {
@@ -31,6 +46,32 @@ type readableTable interface {
 
 	// Creates a cross join tableName Expression using onCondition.
 	CROSS_JOIN(table ReadableTable) joinSelectUpdateTable
+
+	// Creates a natural join tableName Expression, which joins tables on all the columns with the same name.
+	NATURAL_JOIN(table ReadableTable) joinSelectUpdateTable
+
+	// Creates a join tableName Expression on equality of listed columns, which have the same name in both tables:
+	// JOIN table USING (column1, column2). Panics if columns list is empty.
+	JOIN_USING(table ReadableTable, columns ...jet.Column) joinSelectUpdateTable
+}
+
+type indexHintTable interface {
+	// UseIndex returns readable table with USE INDEX (index1, index2...) hint, which limits indexes MySQL can
+	// choose from to the listed ones.
+	UseIndex(index string, indexes ...string) IndexHintTable
+	// ForceIndex returns readable table with FORCE INDEX (index1, index2...) hint, which makes MySQL use listed
+	// indexes and avoid table scan whenever possible.
+	ForceIndex(index string, indexes ...string) IndexHintTable
+	// IgnoreIndex returns readable table with IGNORE INDEX (index1, index2...) hint, which prevents MySQL from
+	// using listed indexes.
+	IgnoreIndex(index string, indexes ...string) IndexHintTable
+}
+
+// IndexHintTable is readable table with index hints. Additional hints are serialized in the order they are added,
+// for instance: table USE INDEX (index1) IGNORE INDEX (index2)
+type IndexHintTable interface {
+	ReadableTable
+	indexHintTable
 }
 
 type joinSelectUpdateTable interface {
@@ -76,10 +117,25 @@ func (r readableTableInterfaceImpl) CROSS_JOIN(table ReadableTable) joinSelectUp
 	return newJoinTable(r.parent, table, jet.CrossJoin, nil)
 }
 
+func (r readableTableInterfaceImpl) NATURAL_JOIN(table ReadableTable) joinSelectUpdateTable {
+	return newJoinTable(r.parent, table, jet.NaturalJoin, nil)
+}
+
+func (r readableTableInterfaceImpl) JOIN_USING(table ReadableTable, columns ...jet.Column) joinSelectUpdateTable {
+	return wrapJoinTable(jet.NewJoinTableUsing(r.parent, table, jet.UnwidColumnList(columns)))
+}
+
 // NewTable creates new table with schema Name, table Name and list of columns
 func NewTable(schemaName, name, alias string, columns ...jet.ColumnExpression) Table {
+	t := newTable(jet.NewTable(schemaName, name, alias, columns...))
+	t.projectionSets = &jet.ProjectionSets{}
+
+	return t
+}
+
+func newTable(serializerTable jet.SerializerTable) *tableImpl {
 	t := &tableImpl{
-		SerializerTable: jet.NewTable(schemaName, name, alias, columns...),
+		SerializerTable: serializerTable,
 	}
 
 	t.readableTableInterfaceImpl.parent = t
@@ -92,10 +148,16 @@ type tableImpl struct {
 	jet.SerializerTable
 	readableTableInterfaceImpl
 	parent Table
+
+	projectionSets *jet.ProjectionSets
 }
 
 func (t *tableImpl) INSERT(columns ...jet.Column) InsertStatement {
-	return newInsertStatement(t.parent, jet.UnwidColumnList(columns))
+	return newInsertStatement(t.parent, columns)
+}
+
+func (t *tableImpl) REPLACE(columns ...jet.Column) ReplaceStatement {
+	return newReplaceStatement(t.parent, jet.UnwidColumnList(columns))
 }
 
 func (t *tableImpl) UPDATE(columns ...jet.Column) UpdateStatement {
@@ -110,14 +172,52 @@ func (t *tableImpl) LOCK() LockStatement {
 	return LOCK(t.parent)
 }
 
+func (t *tableImpl) UseIndex(index string, indexes ...string) IndexHintTable {
+	return t.withIndexHint(jet.UseIndex, index, indexes)
+}
+
+func (t *tableImpl) ForceIndex(index string, indexes ...string) IndexHintTable {
+	return t.withIndexHint(jet.ForceIndex, index, indexes)
+}
+
+func (t *tableImpl) IgnoreIndex(index string, indexes ...string) IndexHintTable {
+	return t.withIndexHint(jet.IgnoreIndex, index, indexes)
+}
+
+func (t *tableImpl) withIndexHint(hintType jet.IndexHintType, index string, indexes []string) IndexHintTable {
+	return newTable(jet.TableWithIndexHint(t.SerializerTable, hintType, append([]string{index}, indexes...)...))
+}
+
+// Projection defines named projection set of table columns, if columns are passed, or returns previously defined
+// projection set otherwise.
+func (t *tableImpl) Projection(name string, columns ...jet.Column) ColumnList {
+	return t.projectionSets.Projection(t, name, columns...)
+}
+
+func (t *tableImpl) Column(name string) Column {
+	return jet.TableColumn(t.parent, name)
+}
+
+func (t *tableImpl) ColumnOrError(name string) (Column, error) {
+	return jet.LookupColumn(t.parent, name)
+}
+
+func (t *tableImpl) Projections() ProjectionList {
+	return jet.TableProjections(t.parent)
+}
+
 type joinTable struct {
 	tableImpl
 	jet.JoinTable
 }
 
 func newJoinTable(lhs jet.Serializer, rhs jet.Serializer, joinType jet.JoinType, onCondition BoolExpression) Table {
+	return wrapJoinTable(jet.NewJoinTable(lhs, rhs, joinType, onCondition))
+}
+
+func wrapJoinTable(table jet.JoinTable) Table {
 	newJoinTable := &joinTable{
-		JoinTable: jet.NewJoinTable(lhs, rhs, joinType, onCondition),
+		JoinTable: table,
 	}
 
 	newJoinTable.readableTableInterfaceImpl.parent = newJoinTable
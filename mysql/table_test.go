@@ -1,9 +1,60 @@
 package mysql
 
 import (
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
+func TestTableQualifiedName(t *testing.T) {
+	require.Equal(t, "`db`.`table1`", table1.QualifiedName(Dialect))
+}
+
+func TestTableProjection(t *testing.T) {
+	idColumn := IntegerColumn("id")
+	nameColumn := StringColumn("name")
+	users := NewTable("db", "users", "", idColumn, nameColumn, StringColumn("bio"))
+
+	users.Projection("summary", idColumn, nameColumn)
+
+	assertStatementSql(t, users.SELECT(users.Projection("summary")), `
+SELECT users.id AS "users.id",
+     users.name AS "users.name"
+FROM db.users;
+`)
+	assertPanicErr(t, func() { users.Projection("list") }, "jet: projection set 'list' is not defined for table 'users'")
+}
+
+func TestTableIndexHints(t *testing.T) {
+	assertSerialize(t, table1.ForceIndex("idx_col_int"), `db.table1 FORCE INDEX (idx_col_int)`)
+	assertSerialize(t, table1.UseIndex("idx_a", "idx_b").IgnoreIndex("idx_c").ForceIndex("PRIMARY"),
+		"db.table1 USE INDEX (idx_a, idx_b) IGNORE INDEX (idx_c) FORCE INDEX (`PRIMARY`)")
+
+	hinted := table1.UseIndex("idx_a")
+	hinted.IgnoreIndex("idx_c")
+	assertSerialize(t, hinted, `db.table1 USE INDEX (idx_a)`)
+
+	assertStatementSql(t, SELECT(table1ColInt, table2ColInt).
+		FROM(table1.UseIndex("idx_col_int").
+			INNER_JOIN(table2.IgnoreIndex("idx_col3"), table1ColInt.EQ(table2ColInt))), `
+SELECT table1.col_int AS "table1.col_int",
+     table2.col_int AS "table2.col_int"
+FROM db.table1 USE INDEX (idx_col_int)
+     INNER JOIN db.table2 IGNORE INDEX (idx_col3) ON (table1.col_int = table2.col_int);
+`)
+
+	assertPanicErr(t, func() { table1.UseIndex("idx_a", " ") }, "jet: USE INDEX hint has invalid index name ' '")
+	assertPanicErr(t, func() { table1.ForceIndex("") }, "jet: FORCE INDEX hint has invalid index name ''")
+}
+
+func TestTableColumn(t *testing.T) {
+	require.Equal(t, table1ColString, table1.Column("col_string"))
+
+	_, err := table1.ColumnOrError("col_missing")
+	require.EqualError(t, err, "jet: column 'col_missing' not found in table 'table1'")
+	assertStatementSqlErr(t, table1.SELECT(table1.Column("col_missing")),
+		"jet: column 'col_missing' not found in table 'table1'")
+}
+
 func TestJoinNilInputs(t *testing.T) {
 	assertSerializeErr(t, table2.INNER_JOIN(nil, table1ColBool.EQ(table2ColBool)),
 		"jet: right hand side of join operation is nil table")
@@ -99,3 +150,33 @@ CROSS JOIN db.table2`)
 CROSS JOIN db.table2
 CROSS JOIN db.table3`)
 }
+
+func TestNATURAL_JOIN(t *testing.T) {
+	assertSerialize(t, table1.
+		NATURAL_JOIN(table2),
+		`db.table1
+NATURAL JOIN db.table2`)
+	assertSerialize(t, table1.
+		NATURAL_JOIN(table2).
+		INNER_JOIN(table3, table1ColInt.EQ(table3ColInt)),
+		`db.table1
+NATURAL JOIN db.table2
+INNER JOIN db.table3 ON (table1.col_int = table3.col_int)`)
+}
+
+func TestJOIN_USING(t *testing.T) {
+	assertSerialize(t, table1.
+		JOIN_USING(table2, table1ColInt),
+		`db.table1
+JOIN db.table2 USING (col_int)`)
+	assertSerialize(t, table1.
+		JOIN_USING(table2, table1ColInt, table1ColFloat).
+		JOIN_USING(table3, table3Col1),
+		`db.table1
+JOIN db.table2 USING (col_int, col_float)
+JOIN db.table3 USING (col1)`)
+
+	assertPanicErr(t, func() {
+		table1.JOIN_USING(table2)
+	}, "jet: join USING requires at least one column")
+}
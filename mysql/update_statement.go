@@ -10,6 +10,9 @@ type UpdateStatement interface {
 	MODEL(data interface{}) UpdateStatement
 
 	WHERE(expression BoolExpression) UpdateStatement
+	// AllRows explicitly allows statement without WHERE clause, which updates all table rows. Statement without
+	// WHERE clause, that is not marked with AllRows, can not be serialized or executed.
+	AllRows() UpdateStatement
 }
 
 type updateStatementImpl struct {
@@ -60,3 +63,8 @@ func (u *updateStatementImpl) WHERE(expression BoolExpression) UpdateStatement {
 	u.Where.Condition = expression
 	return u
 }
+
+func (u *updateStatementImpl) AllRows() UpdateStatement {
+	u.Where.Mandatory = false
+	return u
+}
@@ -80,3 +80,15 @@ func TestInvalidInputs(t *testing.T) {
 	assertStatementSqlErr(t, table1.UPDATE(table1ColInt).SET(1), "jet: WHERE clause not set")
 	assertStatementSqlErr(t, table1.UPDATE(nil).SET(1), "jet: nil column in columns list for SET clause")
 }
+
+func TestUpdateGeneratedColumn(t *testing.T) {
+	idCol := IntegerColumn("id")
+	generatedCol := IntegerColumn("col_generated")
+	SetGenerated(generatedCol)
+	table := NewTable("db", "table", "", idCol, generatedCol)
+
+	assertStatementSqlErr(t, table.UPDATE(generatedCol).SET(1).WHERE(idCol.EQ(Int(1))),
+		"jet: can't assign value to generated column 'col_generated'")
+	assertStatementSqlErr(t, table.UPDATE().SET(generatedCol.SET(Int(1))).WHERE(idCol.EQ(Int(1))),
+		"jet: can't assign value to generated column 'col_generated'")
+}
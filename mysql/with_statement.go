@@ -18,7 +18,8 @@ type commonTableExpression struct {
 	jet.CommonTableExpression
 }
 
-// WITH function creates new WITH statement from list of common table expressions
+// WITH function creates new WITH statement from list of common table expressions. Statement is serialized as
+// WITH RECURSIVE if any of the common table expressions references itself.
 func WITH(cte ...CommonTableExpression) func(statement jet.Statement) Statement {
 	return jet.WITH(Dialect, false, toInternalCTE(cte)...)
 }
@@ -0,0 +1,105 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// ArrayExpression is representation of postgres array
+type ArrayExpression interface {
+	jet.Expression
+
+	EQ(rhs ArrayExpression) BoolExpression
+	NOT_EQ(rhs ArrayExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs ArrayExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs ArrayExpression) BoolExpression
+
+	// CONTAINS checks if array contains all the elements of rhs array
+	CONTAINS(rhs ArrayExpression) BoolExpression
+	// IS_CONTAINED_BY checks if all the array elements are contained in rhs array
+	IS_CONTAINED_BY(rhs ArrayExpression) BoolExpression
+	// OVERLAP checks if array and rhs array have any element in common
+	OVERLAP(rhs ArrayExpression) BoolExpression
+	// CONCAT concatenates array with rhs array
+	CONCAT(rhs ArrayExpression) ArrayExpression
+}
+
+type arrayInterfaceImpl struct {
+	parent ArrayExpression
+}
+
+func (a *arrayInterfaceImpl) EQ(rhs ArrayExpression) BoolExpression {
+	return jet.Eq(a.parent, rhs)
+}
+
+func (a *arrayInterfaceImpl) NOT_EQ(rhs ArrayExpression) BoolExpression {
+	return jet.NotEq(a.parent, rhs)
+}
+
+func (a *arrayInterfaceImpl) IS_DISTINCT_FROM(rhs ArrayExpression) BoolExpression {
+	return jet.IsDistinctFrom(a.parent, rhs)
+}
+
+func (a *arrayInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs ArrayExpression) BoolExpression {
+	return jet.IsNotDistinctFrom(a.parent, rhs)
+}
+
+func (a *arrayInterfaceImpl) CONTAINS(rhs ArrayExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(a.parent, rhs, "@>"))
+}
+
+func (a *arrayInterfaceImpl) IS_CONTAINED_BY(rhs ArrayExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(a.parent, rhs, "<@"))
+}
+
+func (a *arrayInterfaceImpl) OVERLAP(rhs ArrayExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(a.parent, rhs, "&&"))
+}
+
+func (a *arrayInterfaceImpl) CONCAT(rhs ArrayExpression) ArrayExpression {
+	return ArrayExp(jet.NewBinaryOperatorExpression(a.parent, rhs, "||"))
+}
+
+//---------------------------------------------------//
+
+// ARRAY creates new array constructor expression from the list of elements: ARRAY[element1, element2, ...].
+// Empty array has to be cast to array type, for instance: CAST(ARRAY()).AS("integer[]")
+func ARRAY(elements ...Expression) ArrayExpression {
+	return ArrayExp(jet.ARRAY(elements...))
+}
+
+// ARRAY_SUBQUERY creates new array from the results of single column subQuery: ARRAY (SELECT ...)
+func ARRAY_SUBQUERY(subQuery SelectStatement) ArrayExpression {
+	return ArrayExp(jet.ARRAY_SUBQUERY(subQuery))
+}
+
+// ANY returns ANY(array) expression, which is true in comparison if comparison is true for any of the array elements.
+// ANY expression is not typed and has to be wrapped with the type of array elements, for instance:
+// table.ID.EQ(IntExp(ANY(ARRAY(Int(1), Int(2)))))
+func ANY(array ArrayExpression) Expression {
+	return jet.NewFunc("ANY", []Expression{array}, nil)
+}
+
+// ALL returns ALL(array) expression, which is true in comparison if comparison is true for all of the array elements.
+// ALL expression is not typed and has to be wrapped with the type of array elements, for instance:
+// table.Name.NOT_EQ(StringExp(ALL(ARRAY(String("a"), String("b")))))
+func ALL(array ArrayExpression) Expression {
+	return jet.NewFunc("ALL", []Expression{array}, nil)
+}
+
+//---------------------------------------------------//
+
+type arrayWrapper struct {
+	arrayInterfaceImpl
+	Expression
+}
+
+func newArrayExpressionWrap(expression Expression) ArrayExpression {
+	arrayWrap := &arrayWrapper{Expression: expression}
+	arrayWrap.arrayInterfaceImpl.parent = arrayWrap
+	return arrayWrap
+}
+
+// ArrayExp is array expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression, for instance array column, as array expression.
+// Does not add sql cast to generated sql builder output.
+func ArrayExp(expression Expression) ArrayExpression {
+	return newArrayExpressionWrap(expression)
+}
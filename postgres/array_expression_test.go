@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestArrayConstructor(t *testing.T) {
+	assertSerialize(t, ARRAY(Int(1), Int(2), table1ColInt), `ARRAY[$1, $2, table1.col_int]`, int64(1), int64(2))
+	assertSerialize(t, CAST(ARRAY()).AS("integer[]"), `ARRAY[]::integer[]`)
+	assertSerialize(t, ARRAY_SUBQUERY(SELECT(table2ColInt).FROM(table2).WHERE(table2ColBool)), `ARRAY (
+     SELECT table2.col_int AS "table2.col_int"
+     FROM db.table2
+     WHERE table2.col_bool
+)`)
+
+	assertStatementSql(t, table2.INSERT(table2ColInt, table2ColStr).VALUES(1, ARRAY(String("a"), String("b"))), `
+INSERT INTO db.table2 (col_int, col_str)
+VALUES ($1, ARRAY[$2, $3]);
+`, 1, "a", "b")
+}
+
+func TestArrayExpression(t *testing.T) {
+	tags := ArrayExp(table2ColStr)
+
+	assertSerialize(t, tags.CONTAINS(ARRAY(String("a"))), `(table2.col_str @> ARRAY[$1])`, "a")
+	assertSerialize(t, tags.IS_CONTAINED_BY(ARRAY(String("a"), String("b"))), `(table2.col_str <@ ARRAY[$1, $2])`, "a", "b")
+	assertSerialize(t, tags.OVERLAP(ARRAY(String("a"))), `(table2.col_str && ARRAY[$1])`, "a")
+	assertSerialize(t, tags.CONCAT(ARRAY(String("c"))).EQ(ARRAY(String("a"), String("c"))),
+		`((table2.col_str || ARRAY[$1]) = ARRAY[$2, $3])`, "c", "a", "c")
+	assertSerialize(t, tags.IS_DISTINCT_FROM(ARRAY(String("a"))), `(table2.col_str IS DISTINCT FROM ARRAY[$1])`, "a")
+}
+
+func TestArrayANYandALL(t *testing.T) {
+	assertSerialize(t, table1ColInt.EQ(IntExp(ANY(ARRAY(Int(1), Int(2))))), `(table1.col_int = ANY(ARRAY[$1, $2]))`, int64(1), int64(2))
+	assertSerialize(t, table2ColStr.NOT_EQ(StringExp(ALL(ARRAY(String("a"))))), `(table2.col_str != ALL(ARRAY[$1]))`, "a")
+
+	assertStatementSql(t, SELECT(table1ColInt).FROM(table1).WHERE(
+		table1ColInt.EQ(IntExp(ANY(ARRAY_SUBQUERY(SELECT(table2ColInt).FROM(table2))))),
+	), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int = ANY(ARRAY (
+           SELECT table2.col_int AS "table2.col_int"
+           FROM db.table2
+      ));
+`)
+}
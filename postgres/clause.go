@@ -4,6 +4,20 @@ import (
 	"github.com/go-jet/jet/v2/internal/jet"
 )
 
+// clauseSelect is SELECT clause with DISTINCT ON columns matched against ORDER BY clause of the same statement
+type clauseSelect struct {
+	jet.ClauseSelect
+	orderBy *jet.ClauseOrderBy
+}
+
+func (s *clauseSelect) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if len(s.DistinctOnColumns) > 0 && s.orderBy != nil {
+		jet.MustMatchDistinctOn(s.DistinctOnColumns, s.orderBy.List)
+	}
+
+	s.ClauseSelect.Serialize(statementType, out, options...)
+}
+
 type onConflict interface {
 	ON_CONSTRAINT(name string) conflictTarget
 	WHERE(indexPredicate BoolExpression) conflictTarget
@@ -18,3 +18,13 @@ func TestNewIntervalColumn(t *testing.T) {
 	assertSerialize(t, subQueryIntervalColumn2.EQ(INTERVAL(1, DAY)), `(sub_query."table1.col_interval" = INTERVAL '1 DAY')`)
 	assertProjectionSerialize(t, subQueryIntervalColumn2, `sub_query."table1.col_interval" AS "table1.col_interval"`)
 }
+
+func TestNewExpressionColumn(t *testing.T) {
+	fullName := NewExpressionColumn("full_name", table3StrCol.CONCAT(String(" ")))
+
+	assertStatementSql(t, SELECT(fullName).FROM(table3).WHERE(fullName.IS_NOT_NULL()), `
+SELECT (table3.col2 || $1) AS "full_name"
+FROM db.table3
+WHERE (table3.col2 || $2) IS NOT NULL;
+`, " ", " ")
+}
@@ -1,6 +1,10 @@
 package postgres
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"context"
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
 
 // DeleteStatement is interface for PostgreSQL DELETE statement
 type DeleteStatement interface {
@@ -8,7 +12,13 @@ type DeleteStatement interface {
 
 	USING(tables ...ReadableTable) DeleteStatement
 	WHERE(expression BoolExpression) DeleteStatement
+	// AllRows explicitly allows statement without WHERE clause, which deletes all table rows. Statement without
+	// WHERE clause, that is not marked with AllRows, can not be serialized or executed.
+	AllRows() DeleteStatement
 	RETURNING(projections ...jet.Projection) DeleteStatement
+
+	// ExplainJSON executes EXPLAIN (FORMAT JSON) of the statement over db connection/transaction and returns parsed execution plan
+	ExplainJSON(ctx context.Context, db qrm.DB) (*QueryPlan, error)
 }
 
 type deleteStatementImpl struct {
@@ -46,7 +56,16 @@ func (d *deleteStatementImpl) WHERE(expression BoolExpression) DeleteStatement {
 	return d
 }
 
+func (d *deleteStatementImpl) AllRows() DeleteStatement {
+	d.Where.Mandatory = false
+	return d
+}
+
 func (d *deleteStatementImpl) RETURNING(projections ...jet.Projection) DeleteStatement {
 	d.Returning.ProjectionList = projections
 	return d
 }
+
+func (d *deleteStatementImpl) ExplainJSON(ctx context.Context, db qrm.DB) (*QueryPlan, error) {
+	return explainJSON(ctx, db, d)
+}
@@ -1,6 +1,9 @@
 package postgres
 
 import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
@@ -9,6 +12,26 @@ func TestDeleteUnconditionally(t *testing.T) {
 	assertStatementSqlErr(t, table1.DELETE().WHERE(nil), `jet: WHERE clause not set`)
 }
 
+func TestDeleteAllRows(t *testing.T) {
+	assertStatementSql(t, table1.DELETE().AllRows(), `
+DELETE FROM db.table1;
+`)
+
+	db := &queryRecorderDB{}
+
+	_, err := table1.DELETE().ExecContext(context.Background(), db)
+	require.True(t, errors.Is(err, ErrMissingWhere))
+	require.EqualError(t, err, "jet: DELETE statement without WHERE clause affects all rows, use AllRows to allow it, missing WHERE clause")
+
+	err = table1.DELETE().RETURNING(table1Col1).Query(db, &struct{}{})
+	require.True(t, errors.Is(err, ErrMissingWhere))
+	require.Empty(t, db.queries)
+
+	_, err = table1.DELETE().AllRows().Exec(db)
+	require.True(t, errors.Is(err, errQueryRecorded))
+	require.Equal(t, []string{"\nDELETE FROM db.table1;\n"}, db.queries)
+}
+
 func TestDeleteWithWhere(t *testing.T) {
 	assertStatementSql(t, table1.DELETE().WHERE(table1Col1.EQ(Int(1))), `
 DELETE FROM db.table1
@@ -23,3 +46,25 @@ WHERE table1.col1 = $1
 RETURNING table1.col1 AS "table1.col1";
 `, int64(1))
 }
+
+func TestDeleteWithSubQueryAndReturning(t *testing.T) {
+	dequeue := SELECT(table1Col1).
+		FROM(table1).
+		WHERE(table1ColBool.IS_TRUE()).
+		ORDER_BY(table1ColInt.DESC()).
+		LIMIT(10).
+		FOR(UPDATE().SKIP_LOCKED())
+
+	assertStatementSql(t, table1.DELETE().WHERE(table1Col1.IN_SELECT(dequeue)).RETURNING(STAR), `
+DELETE FROM db.table1
+WHERE table1.col1 IN (
+           SELECT table1.col1 AS "table1.col1"
+           FROM db.table1
+           WHERE table1.col_bool IS TRUE
+           ORDER BY table1.col_int DESC
+           LIMIT $1
+           FOR UPDATE SKIP LOCKED
+      )
+RETURNING *;
+`, int64(10))
+}
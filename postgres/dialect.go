@@ -1,8 +1,10 @@
 package postgres
 
 import (
+	"encoding/hex"
 	"github.com/go-jet/jet/v2/internal/jet"
 	"strconv"
+	"strings"
 )
 
 // Dialect is implementation of postgres dialect for SQL Builder serialisation.
@@ -24,12 +26,38 @@ func newDialect() jet.Dialect {
 		ArgumentPlaceholder: func(ord int) string {
 			return "$" + strconv.Itoa(ord)
 		},
-		ReservedWords: reservedWords,
+		ArgumentToString: postgresArgumentToString,
+		ReservedWords:    reservedWords,
+		MaxParameters:    65535,
+		OrderByAlias:     true,
 	}
 
 	return jet.NewDialect(dialectParams)
 }
 
+func postgresArgumentToString(value interface{}) (string, bool) {
+	switch bindValue := value.(type) {
+	case string:
+		return postgresStringQuote(bindValue), true
+	case []byte:
+		return `'\x` + hex.EncodeToString(bindValue) + `'`, true
+	}
+
+	return "", false
+}
+
+var postgresEscapeStringReplacer = strings.NewReplacer(`\`, `\\`, `'`, `''`)
+
+// postgresStringQuote writes string containing backslashes as escape string constant(E'...'), so that backslashes
+// are not interpreted differently depending on standard_conforming_strings server setting.
+func postgresStringQuote(value string) string {
+	if !strings.Contains(value, `\`) {
+		return `'` + strings.Replace(value, "'", "''", -1) + `'`
+	}
+
+	return `E'` + postgresEscapeStringReplacer.Replace(value) + `'`
+}
+
 func postgresCAST(expressions ...jet.Serializer) jet.SerializerFunc {
 	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
 		if len(expressions) < 2 {
@@ -50,6 +78,14 @@ func postgresCAST(expressions ...jet.Serializer) jet.SerializerFunc {
 			panic("jet: cast type is not string")
 		}
 
+		if out.NamedArguments() {
+			// :: cast operator would be written right after :name placeholder, and mistaken for a part of it
+			out.WriteString("CAST(")
+			jet.Serialize(expression, statement, out, options...)
+			out.WriteString("AS " + castType + ")")
+			return
+		}
+
 		jet.Serialize(expression, statement, out, options...)
 		out.WriteString("::" + castType)
 	}
@@ -86,3 +86,11 @@ func TestReservedWordEscaped(t *testing.T) {
 	assertSerialize(t, table1ColVariadic, `table1."VARIADIC"`)
 	assertSerialize(t, table1ColProcedure, `table1.procedure`)
 }
+
+func TestArgumentToString(t *testing.T) {
+	assertDebugStatementSql(t, SELECT(String("O'Reilly"), String(`C:\temp\' OR 1=1 --`), Bytea([]byte("\x00\xffab"))), `
+SELECT 'O''Reilly',
+     E'C:\\temp\\'' OR 1=1 --',
+     '\x00ff6162'::bytea;
+`)
+}
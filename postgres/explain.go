@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// QueryPlan is a node of statement execution plan, parsed from EXPLAIN (FORMAT JSON) output
+type QueryPlan struct {
+	NodeType     string  `json:"Node Type"`
+	RelationName string  `json:"Relation Name"`
+	Alias        string  `json:"Alias"`
+	IndexName    string  `json:"Index Name"`
+	StartupCost  float64 `json:"Startup Cost"`
+	TotalCost    float64 `json:"Total Cost"`
+	PlanRows     int64   `json:"Plan Rows"`
+	PlanWidth    int64   `json:"Plan Width"`
+
+	Plans []QueryPlan `json:"Plans"`
+}
+
+// HasNodeType returns true if this plan node or any of its child nodes is of nodeType, for instance "Index Scan" or "Seq Scan"
+func (q QueryPlan) HasNodeType(nodeType string) bool {
+	if q.NodeType == nodeType {
+		return true
+	}
+
+	for _, plan := range q.Plans {
+		if plan.HasNodeType(nodeType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func explainJSON(ctx context.Context, db qrm.DB, statement Statement) (*QueryPlan, error) {
+	query, args, err := jet.SqlContext(ctx, statement)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, "EXPLAIN (FORMAT JSON)"+query, args...)
+
+	if err != nil {
+		return nil, fmt.Errorf("jet: %w", err)
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("jet: %w", err)
+		}
+		return nil, errors.New("jet: EXPLAIN returned no rows")
+	}
+
+	var planJSON []byte
+
+	if err := rows.Scan(&planJSON); err != nil {
+		return nil, fmt.Errorf("jet: rows scan error, %w", err)
+	}
+
+	return parseQueryPlan(planJSON)
+}
+
+func parseQueryPlan(planJSON []byte) (*QueryPlan, error) {
+	var explained []struct {
+		Plan QueryPlan `json:"Plan"`
+	}
+
+	if err := json.Unmarshal(planJSON, &explained); err != nil {
+		return nil, fmt.Errorf("jet: failed to parse EXPLAIN output, %w", err)
+	}
+
+	if len(explained) == 0 {
+		return nil, errors.New("jet: EXPLAIN output is empty")
+	}
+
+	return &explained[0].Plan, nil
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseQueryPlan(t *testing.T) {
+	plan, err := parseQueryPlan([]byte(`
+[
+  {
+    "Plan": {
+      "Node Type": "Nested Loop",
+      "Parallel Aware": false,
+      "Join Type": "Inner",
+      "Startup Cost": 0.29,
+      "Total Cost": 16.34,
+      "Plan Rows": 1,
+      "Plan Width": 72,
+      "Plans": [
+        {
+          "Node Type": "Seq Scan",
+          "Parent Relationship": "Outer",
+          "Relation Name": "table2",
+          "Alias": "table2",
+          "Startup Cost": 0.00,
+          "Total Cost": 8.01,
+          "Plan Rows": 1,
+          "Plan Width": 36
+        },
+        {
+          "Node Type": "Index Scan",
+          "Parent Relationship": "Inner",
+          "Scan Direction": "Forward",
+          "Index Name": "table1_pkey",
+          "Relation Name": "table1",
+          "Alias": "table1",
+          "Startup Cost": 0.29,
+          "Total Cost": 8.31,
+          "Plan Rows": 1,
+          "Plan Width": 36
+        }
+      ]
+    }
+  }
+]`))
+
+	require.NoError(t, err)
+	require.Equal(t, &QueryPlan{
+		NodeType:    "Nested Loop",
+		StartupCost: 0.29,
+		TotalCost:   16.34,
+		PlanRows:    1,
+		PlanWidth:   72,
+		Plans: []QueryPlan{
+			{
+				NodeType:     "Seq Scan",
+				RelationName: "table2",
+				Alias:        "table2",
+				TotalCost:    8.01,
+				PlanRows:     1,
+				PlanWidth:    36,
+			},
+			{
+				NodeType:     "Index Scan",
+				RelationName: "table1",
+				Alias:        "table1",
+				IndexName:    "table1_pkey",
+				StartupCost:  0.29,
+				TotalCost:    8.31,
+				PlanRows:     1,
+				PlanWidth:    36,
+			},
+		},
+	}, plan)
+
+	require.True(t, plan.HasNodeType("Index Scan"))
+	require.True(t, plan.HasNodeType("Seq Scan"))
+	require.False(t, plan.HasNodeType("Bitmap Heap Scan"))
+
+	_, err = parseQueryPlan([]byte(`[]`))
+	require.EqualError(t, err, "jet: EXPLAIN output is empty")
+
+	_, err = parseQueryPlan([]byte(`Seq Scan on table1`))
+	require.Error(t, err)
+}
+
+func TestExplainJSON(t *testing.T) {
+	db := &queryRecorderDB{}
+	ctx := WithSchema(context.Background(), "tenant_1")
+
+	_, err := SELECT(table1Col1).FROM(table1).WHERE(table1Col1.EQ(Int(1))).ExplainJSON(ctx, db)
+	require.True(t, errors.Is(err, errQueryRecorded))
+	_, err = table1.UPDATE(table1ColInt).SET(2).WHERE(table1Col1.EQ(Int(1))).ExplainJSON(context.Background(), db)
+	require.True(t, errors.Is(err, errQueryRecorded))
+	_, err = table1.UPDATE(table1ColInt).SET(2).ExplainJSON(context.Background(), db)
+	require.True(t, errors.Is(err, ErrMissingWhere))
+
+	require.Equal(t, []string{`EXPLAIN (FORMAT JSON)
+SELECT table1.col1 AS "table1.col1"
+FROM tenant_1.table1
+WHERE table1.col1 = $1;
+`, `EXPLAIN (FORMAT JSON)
+UPDATE db.table1
+SET col_int = $1
+WHERE table1.col1 = $2;
+`}, db.queries)
+}
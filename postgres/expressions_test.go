@@ -74,3 +74,43 @@ func TestRawHelperMethods(t *testing.T) {
 	assertSerialize(t, RawDate("table.colDate").EQ(DateT(now)),
 		"((table.colDate) = $1::date)", now)
 }
+
+func TestTimestampAT_TIME_ZONE(t *testing.T) {
+	assertSerialize(t, table1ColTimestampz.AT_TIME_ZONE(String("America/New_York")),
+		"(table1.col_timestampz AT TIME ZONE $1)", "America/New_York")
+	assertSerialize(t, table1ColTimestamp.AT_TIME_ZONE(table2ColStr).EQ(table2ColTimestamp),
+		"((table1.col_timestamp AT TIME ZONE table2.col_str) = table2.col_timestamp)")
+}
+
+func TestIN_SELECT(t *testing.T) {
+	subQuery := SELECT(table2ColInt).
+		FROM(table2).
+		WHERE(table2ColBool.IS_TRUE())
+
+	assertSerialize(t, table1ColInt.IN_SELECT(subQuery), `(table1.col_int IN (
+     SELECT table2.col_int AS "table2.col_int"
+     FROM db.table2
+     WHERE table2.col_bool IS TRUE
+))`)
+	assertSerialize(t, table1ColInt.NOT_IN_SELECT(subQuery), `(table1.col_int NOT IN (
+     SELECT table2.col_int AS "table2.col_int"
+     FROM db.table2
+     WHERE table2.col_bool IS TRUE
+))`)
+	assertSerialize(t, table1ColInt.IN_SELECT(SELECT(MAXi(table2ColInt).AS("max")).FROM(table2)), `(table1.col_int IN (
+     SELECT MAX(table2.col_int) AS "max"
+     FROM db.table2
+))`)
+}
+
+func TestIN_SELECT_InvalidProjections(t *testing.T) {
+	assertPanicErr(t, func() {
+		table1ColInt.IN_SELECT(SELECT(table2ColInt, table2ColFloat).FROM(table2))
+	}, "jet: IN_SELECT sub-query has to project exactly one column, got 2")
+	assertPanicErr(t, func() {
+		table1ColInt.NOT_IN_SELECT(SELECT(ColumnList{table2ColInt, table2ColStr}, table2ColFloat).FROM(table2))
+	}, "jet: NOT_IN_SELECT sub-query has to project exactly one column, got 3")
+	assertPanicErr(t, func() {
+		table1ColInt.IN_SELECT(SELECT(table2ColStr).FROM(table2))
+	}, "jet: IN_SELECT sub-query projects string column, but integer expression is expected")
+}
@@ -0,0 +1,31 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+type functionTable interface {
+	// AS creates readable table from set-returning function call with alias and result columns, for instance:
+	// my_func($1) AS t(a, b). Result columns are referenced in the rest of the query the same way as sub-query
+	// columns, through column.From(table) or table.AllColumns().
+	AS(alias string, columns ...jet.ColumnExpression) SelectTable
+}
+
+// FunctionTable creates call of set-returning function name with args, which can be queried, once aliased, like
+// any other table. Function arguments are bound as query parameters.
+func FunctionTable(name string, args ...Expression) functionTable {
+	return functionTableImpl{name: name, args: args}
+}
+
+type functionTableImpl struct {
+	name string
+	args []Expression
+}
+
+func (f functionTableImpl) AS(alias string, columns ...jet.ColumnExpression) SelectTable {
+	table := &selectTableImpl{
+		SelectTable: jet.NewFunctionTable(f.name, f.args, alias, columns...),
+	}
+
+	table.readableTableInterfaceImpl.parent = table
+
+	return table
+}
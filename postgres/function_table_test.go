@@ -0,0 +1,46 @@
+package postgres
+
+import "testing"
+
+func TestFunctionTable(t *testing.T) {
+	id := IntegerColumn("id")
+	title := StringColumn("title")
+	films := FunctionTable("db.films_in_stock", Int(1), String("store")).AS("f", id, title)
+	filmID := id.From(films)
+	filmTitle := title.From(films)
+
+	assertStatementSql(t, SELECT(filmID, filmTitle).FROM(films).WHERE(filmTitle.LIKE(String("A%"))), `
+SELECT f.id AS "id",
+     f.title AS "title"
+FROM db.films_in_stock($1, $2) AS f(id, title)
+WHERE f.title LIKE $3;
+`, int64(1), "store", "A%")
+
+	assertDebugStatementSql(t, SELECT(table1Col1, filmTitle).
+		FROM(table1.INNER_JOIN(films, filmID.EQ(table1Col1))), `
+SELECT table1.col1 AS "table1.col1",
+     f.title AS "title"
+FROM db.table1
+     INNER JOIN db.films_in_stock(1, 'store') AS f(id, title) ON (f.id = table1.col1);
+`)
+
+	series := FunctionTable("generate_series", Int(1), Int(3)).AS("s", IntegerColumn("n"))
+	assertDebugStatementSql(t, series.SELECT(series.AllColumns()), `
+SELECT s.n AS "n"
+FROM generate_series(1, 3) AS s(n);
+`)
+
+	assertPanicErr(t, func() {
+		FunctionTable("generate_series", Int(1), Int(3)).AS("")
+	}, "jet: function table 'generate_series' alias is empty")
+}
+
+func TestFunctionTableDoesNotModifyColumns(t *testing.T) {
+	id := IntegerColumn("id")
+	FunctionTable("db.films_in_stock", Int(1)).AS("f", id)
+
+	assertDebugStatementSql(t, SELECT(id).FROM(table1), `
+SELECT id AS "id"
+FROM db.table1;
+`)
+}
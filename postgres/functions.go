@@ -57,6 +57,10 @@ var LOG = jet.LOG
 
 // ----------------- Aggregate functions  -------------------//
 
+// ARRAY_AGG is aggregate function. Collects input values, including nulls, into an array.
+// Aggregated values can be made distinct and ordered with DISTINCT and ORDER_BY.
+var ARRAY_AGG = jet.ARRAY_AGG
+
 // AVG is aggregate function used to calculate avg value from numeric expression
 var AVG = jet.AVG
 
@@ -75,6 +79,9 @@ var BOOL_OR = jet.BOOL_OR
 // COUNT is aggregate function. Returns number of input rows for which the value of expression is not null.
 var COUNT = jet.COUNT
 
+// COUNT_STAR is aggregate function. Returns number of all input rows, COUNT(*).
+var COUNT_STAR = jet.COUNT_STAR
+
 // EVERY is aggregate function. Returns true if all input values are true, otherwise false
 var EVERY = jet.EVERY
 
@@ -96,6 +103,10 @@ var MINf = jet.MINf
 // MINi is aggregate function. Returns minimum value of int expression across all input values
 var MINi = jet.MINi
 
+// STRING_AGG is aggregate function. Concatenates non-null input values into a string, separated by delimiter.
+// Aggregated values can be made distinct and ordered with DISTINCT and ORDER_BY.
+var STRING_AGG = jet.STRING_AGG
+
 // SUM is aggregate function. Returns sum of all expressions
 var SUM = jet.SUM
 
@@ -178,6 +189,10 @@ var RTRIM = jet.RTRIM
 // CHR returns character with the given code.
 var CHR = jet.CHR
 
+// EscapeLike escapes LIKE wildcards('%' and '_') and escape character in value, so value can be matched literally
+// inside pattern with ESCAPE '\' clause. StringExpression CONTAINS escapes text the same way.
+var EscapeLike = jet.EscapeLike
+
 // CONCAT adds two or more expressions together
 var CONCAT = func(expressions ...Expression) StringExpression {
 	return jet.CONCAT(explicitLiteralCasts(expressions...)...)
@@ -297,6 +312,17 @@ var LOCALTIMESTAMP = jet.LOCALTIMESTAMP
 // NOW returns current date and time
 var NOW = jet.NOW
 
+//----------------- Session Information Functions ---------------//
+
+// CURRENT_USER returns user name of current execution context
+var CURRENT_USER = jet.CURRENT_USER
+
+// SESSION_USER returns session user name
+var SESSION_USER = jet.SESSION_USER
+
+// CURRENT_SCHEMA returns name of the schema first in the search path
+var CURRENT_SCHEMA = jet.CURRENT_SCHEMA
+
 // --------------- Conditional Expressions Functions -------------//
 
 // COALESCE function returns the first of its arguments that is not null.
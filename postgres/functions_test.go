@@ -1,6 +1,9 @@
 package postgres
 
-import "testing"
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
 
 func TestROW(t *testing.T) {
 	assertSerialize(t, ROW(SELECT(Int(1))), `ROW((
@@ -10,3 +13,33 @@ func TestROW(t *testing.T) {
      SELECT $2
 ), $3)`)
 }
+
+func TestSTRING_AGG(t *testing.T) {
+	assertSerialize(t, STRING_AGG(table2ColStr, String(",")).DISTINCT().ORDER_BY(table2ColStr),
+		"STRING_AGG(DISTINCT table2.col_str, $1 ORDER BY table2.col_str)", ",")
+}
+
+func TestARRAY_AGG(t *testing.T) {
+	assertSerialize(t, ARRAY_AGG(table1ColInt).DISTINCT().ORDER_BY(table1ColInt.DESC()),
+		"ARRAY_AGG(DISTINCT table1.col_int ORDER BY table1.col_int DESC)")
+}
+
+func TestSessionInformationFunctions(t *testing.T) {
+	assertSerialize(t, CURRENT_USER(), "CURRENT_USER")
+	assertSerialize(t, SESSION_USER(), "SESSION_USER")
+	assertSerialize(t, CURRENT_SCHEMA(), "CURRENT_SCHEMA")
+
+	stmt := SELECT(table2ColStr, CURRENT_SCHEMA().AS("schema")).
+		FROM(table2).
+		WHERE(table2ColStr.EQ(CURRENT_USER()).OR(table2ColStr.EQ(SESSION_USER())))
+
+	assertStatementSql(t, stmt, `
+SELECT table2.col_str AS "table2.col_str",
+     CURRENT_SCHEMA AS "schema"
+FROM db.table2
+WHERE (table2.col_str = CURRENT_USER) OR (table2.col_str = SESSION_USER);
+`)
+
+	_, args := stmt.Sql()
+	require.Empty(t, args)
+}
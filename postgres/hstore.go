@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// HStoreMap is model type for PostgreSQL hstore values. Keys with NULL value are stored as keys with nil value, so that
+// NULL values can be distinguished from absent keys. Nil map represents NULL hstore.
+type HStoreMap map[string]*string
+
+// Scan implements the Scanner interface.
+func (h *HStoreMap) Scan(value interface{}) error {
+	var text string
+
+	switch v := value.(type) {
+	case nil:
+		*h = nil
+		return nil
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return fmt.Errorf("jet: can't scan hstore from %T", value)
+	}
+
+	hstore, err := parseHStore(text)
+
+	if err != nil {
+		return fmt.Errorf("jet: invalid hstore '%s', %w", text, err)
+	}
+
+	*h = hstore
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (h HStoreMap) Value() (driver.Value, error) {
+	if h == nil {
+		return nil, nil
+	}
+
+	return hstoreText(h), nil
+}
+
+// parseHStore parses hstore text representation, for instance: "key1"=>"value1", "key2"=>NULL
+func parseHStore(text string) (HStoreMap, error) {
+	ret := HStoreMap{}
+	rest := strings.TrimSpace(text)
+
+	for rest != "" {
+		key, quoted, next, err := hstoreToken(rest)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !quoted && strings.EqualFold(key, "NULL") {
+			return nil, fmt.Errorf("key can not be NULL")
+		}
+
+		next = strings.TrimSpace(next)
+
+		if !strings.HasPrefix(next, "=>") {
+			return nil, fmt.Errorf("expected '=>' after key '%s'", key)
+		}
+
+		value, quoted, next, err := hstoreToken(strings.TrimSpace(next[len("=>"):]))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !quoted && strings.EqualFold(value, "NULL") {
+			ret[key] = nil
+		} else {
+			ret[key] = &value
+		}
+
+		rest = strings.TrimSpace(next)
+
+		if rest == "" {
+			break
+		}
+
+		if rest[0] != ',' {
+			return nil, fmt.Errorf("expected ',' after value of key '%s'", key)
+		}
+
+		rest = strings.TrimSpace(rest[1:])
+	}
+
+	return ret, nil
+}
+
+// hstoreToken reads double quoted or unquoted hstore key or value from the beginning of text, and returns the unescaped
+// token and the rest of the text.
+func hstoreToken(text string) (token string, quoted bool, rest string, err error) {
+	if text == "" {
+		return "", false, "", fmt.Errorf("unexpected end of text")
+	}
+
+	if text[0] != '"' {
+		end := strings.IndexAny(text, "=>, ")
+
+		if end < 0 {
+			end = len(text)
+		}
+
+		if end == 0 {
+			return "", false, "", fmt.Errorf("unexpected '%c'", text[0])
+		}
+
+		return text[:end], false, text[end:], nil
+	}
+
+	var builder strings.Builder
+
+	for i := 1; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			i++
+			if i == len(text) {
+				return "", false, "", fmt.Errorf("unterminated quoted string")
+			}
+			builder.WriteByte(text[i])
+		case '"':
+			return builder.String(), true, text[i+1:], nil
+		default:
+			builder.WriteByte(text[i])
+		}
+	}
+
+	return "", false, "", fmt.Errorf("unterminated quoted string")
+}
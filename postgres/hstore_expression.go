@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// HStoreExpression is representation of postgres hstore key/value set
+type HStoreExpression interface {
+	jet.Expression
+
+	EQ(rhs HStoreExpression) BoolExpression
+	NOT_EQ(rhs HStoreExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs HStoreExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs HStoreExpression) BoolExpression
+
+	// GET returns value for key, or NULL if key is not present
+	GET(key StringExpression) StringExpression
+	// CONTAINS checks if hstore contains all the key/value pairs of rhs
+	CONTAINS(rhs HStoreExpression) BoolExpression
+	// HAS_KEY checks if hstore contains key
+	HAS_KEY(key StringExpression) BoolExpression
+}
+
+type hstoreInterfaceImpl struct {
+	parent HStoreExpression
+}
+
+func (h *hstoreInterfaceImpl) EQ(rhs HStoreExpression) BoolExpression {
+	return jet.Eq(h.parent, rhs)
+}
+
+func (h *hstoreInterfaceImpl) NOT_EQ(rhs HStoreExpression) BoolExpression {
+	return jet.NotEq(h.parent, rhs)
+}
+
+func (h *hstoreInterfaceImpl) IS_DISTINCT_FROM(rhs HStoreExpression) BoolExpression {
+	return jet.IsDistinctFrom(h.parent, rhs)
+}
+
+func (h *hstoreInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs HStoreExpression) BoolExpression {
+	return jet.IsNotDistinctFrom(h.parent, rhs)
+}
+
+func (h *hstoreInterfaceImpl) GET(key StringExpression) StringExpression {
+	return StringExp(jet.NewBinaryOperatorExpression(h.parent, key, "->"))
+}
+
+func (h *hstoreInterfaceImpl) CONTAINS(rhs HStoreExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(h.parent, rhs, "@>"))
+}
+
+func (h *hstoreInterfaceImpl) HAS_KEY(key StringExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(h.parent, key, "?"))
+}
+
+//---------------------------------------------------//
+
+// HStore creates new hstore literal expression from the map of key/value pairs.
+// Keys with empty string value are present in hstore, and are different from absent keys.
+func HStore(value map[string]string) HStoreExpression {
+	nullableValue := make(map[string]*string, len(value))
+
+	for key := range value {
+		keyValue := value[key]
+		nullableValue[key] = &keyValue
+	}
+
+	return HStoreNullable(nullableValue)
+}
+
+// HStoreNullable creates new hstore literal expression from the map of key/value pairs.
+// Keys with nil value are serialized as NULL values, keys with empty string value are serialized as empty strings.
+func HStoreNullable(value map[string]*string) HStoreExpression {
+	return HStoreExp(CAST(jet.Literal(hstoreText(value))).AS("hstore"))
+}
+
+// hstoreText returns hstore text representation of value, for instance: "key1"=>"value1", "key2"=>NULL
+func hstoreText(value map[string]*string) string {
+	keys := make([]string, 0, len(value))
+
+	for key := range value {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		keyValue := "NULL"
+
+		if value[key] != nil {
+			keyValue = hstoreQuote(*value[key])
+		}
+
+		pairs = append(pairs, hstoreQuote(key)+"=>"+keyValue)
+	}
+
+	return strings.Join(pairs, ", ")
+}
+
+var hstoreEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+func hstoreQuote(text string) string {
+	return `"` + hstoreEscaper.Replace(text) + `"`
+}
+
+//---------------------------------------------------//
+
+type hstoreWrapper struct {
+	hstoreInterfaceImpl
+	Expression
+}
+
+func newHStoreExpressionWrap(expression Expression) HStoreExpression {
+	hstoreWrap := &hstoreWrapper{Expression: expression}
+	hstoreWrap.hstoreInterfaceImpl.parent = hstoreWrap
+	return hstoreWrap
+}
+
+// HStoreExp is hstore expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as hstore expression.
+// Does not add sql cast to generated sql builder output.
+func HStoreExp(expression Expression) HStoreExpression {
+	return newHStoreExpressionWrap(expression)
+}
@@ -0,0 +1,28 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestHStoreExpression(t *testing.T) {
+	attributes := HStoreColumn("attributes").From(SELECT(table1Col1).FROM(table1).AsTable("sub_query"))
+
+	assertSerialize(t, attributes.GET(String("color")), `(sub_query.attributes -> $1)`, "color")
+	assertSerialize(t, attributes.HAS_KEY(String("color")), `(sub_query.attributes ? $1)`, "color")
+	assertSerialize(t, attributes.CONTAINS(HStore(map[string]string{"color": "red"})),
+		`(sub_query.attributes @> $1::hstore)`, `"color"=>"red"`)
+	assertSerialize(t, attributes.EQ(HStoreExp(String(`"size"=>"L"`))), `(sub_query.attributes = $1)`, `"size"=>"L"`)
+	assertSerialize(t, attributes.GET(String("color")).EQ(String("red")), `((sub_query.attributes -> $1) = $2)`, "color", "red")
+}
+
+func TestHStoreLiteral(t *testing.T) {
+	assertSerialize(t, HStore(map[string]string{}), `$1::hstore`, ``)
+	assertSerialize(t, HStore(map[string]string{"b": "2", "a": "1", "empty": ""}),
+		`$1::hstore`, `"a"=>"1", "b"=>"2", "empty"=>""`)
+	assertSerialize(t, HStore(map[string]string{`key "quoted"`: `C:\dir`}),
+		`$1::hstore`, `"key \"quoted\""=>"C:\\dir"`)
+
+	empty := ""
+	assertSerialize(t, HStoreNullable(map[string]*string{"empty": &empty, "null": nil}),
+		`$1::hstore`, `"empty"=>"", "null"=>NULL`)
+}
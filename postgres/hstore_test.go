@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestHStoreMapScanAndValue(t *testing.T) {
+	var hstore HStoreMap
+
+	require.NoError(t, hstore.Scan(`"a"=>"1", "empty"=>"", "null"=>NULL, "key \"quoted\""=>"C:\\dir"`))
+	require.Len(t, hstore, 4)
+	require.Equal(t, "1", *hstore["a"])
+	require.Equal(t, "", *hstore["empty"])
+	require.Contains(t, hstore, "null")
+	require.Nil(t, hstore["null"])
+	require.NotContains(t, hstore, "absent")
+	require.Equal(t, `C:\dir`, *hstore[`key "quoted"`])
+
+	value, err := hstore.Value()
+	require.NoError(t, err)
+	require.Equal(t, `"a"=>"1", "empty"=>"", "key \"quoted\""=>"C:\\dir", "null"=>NULL`, value)
+
+	require.NoError(t, hstore.Scan([]byte("")))
+	require.Equal(t, HStoreMap{}, hstore)
+	value, err = hstore.Value()
+	require.NoError(t, err)
+	require.Equal(t, "", value)
+
+	require.NoError(t, hstore.Scan(nil))
+	require.Nil(t, hstore)
+	value, err = hstore.Value()
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	require.EqualError(t, hstore.Scan(int64(1)), "jet: can't scan hstore from int64")
+	require.EqualError(t, hstore.Scan(`"a"=>"1" "b"=>"2"`), `jet: invalid hstore '"a"=>"1" "b"=>"2"', expected ',' after value of key 'a'`)
+	require.EqualError(t, hstore.Scan(`"a"=>"1`), `jet: invalid hstore '"a"=>"1', unterminated quoted string`)
+}
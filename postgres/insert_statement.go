@@ -1,6 +1,10 @@
 package postgres
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"context"
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
 
 // InsertStatement is interface for SQL INSERT statements
 type InsertStatement interface {
@@ -13,23 +17,32 @@ type InsertStatement interface {
 	MODEL(data interface{}) InsertStatement
 	MODELS(data interface{}) InsertStatement
 	QUERY(selectStatement SelectStatement) InsertStatement
+	// OVERRIDING_SYSTEM_VALUE allows explicit values to be inserted into GENERATED ALWAYS AS IDENTITY columns
+	OVERRIDING_SYSTEM_VALUE() InsertStatement
 
 	ON_CONFLICT(indexExpressions ...jet.ColumnExpression) onConflict
 
 	RETURNING(projections ...Projection) InsertStatement
+
+	// ExplainJSON executes EXPLAIN (FORMAT JSON) of the statement over db connection/transaction and returns parsed execution plan
+	ExplainJSON(ctx context.Context, db qrm.DB) (*QueryPlan, error)
 }
 
 func newInsertStatement(table WritableTable, columns []jet.Column) InsertStatement {
 	newInsert := &insertStatementImpl{}
 	newInsert.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, newInsert,
 		&newInsert.Insert,
+		&newInsert.Overriding,
 		&newInsert.ValuesQuery,
 		&newInsert.OnConflict,
 		&newInsert.Returning,
 	)
 
 	newInsert.Insert.Table = table
-	newInsert.Insert.Columns = columns
+	newInsert.Insert.SetColumns(columns)
+	newInsert.ValuesQuery.DefaultRow = "DEFAULT VALUES"
+	newInsert.Overriding.Name = "OVERRIDING SYSTEM VALUE"
+	newInsert.Overriding.InNewLine = true
 
 	return newInsert
 }
@@ -38,6 +51,7 @@ type insertStatementImpl struct {
 	jet.SerializerStatement
 
 	Insert      jet.ClauseInsert
+	Overriding  jet.ClauseOptional
 	ValuesQuery jet.ClauseValuesQuery
 	Returning   jet.ClauseReturning
 	OnConflict  onConflictClause
@@ -68,6 +82,12 @@ func (i *insertStatementImpl) QUERY(selectStatement SelectStatement) InsertState
 	return i
 }
 
+func (i *insertStatementImpl) OVERRIDING_SYSTEM_VALUE() InsertStatement {
+	i.Overriding.Show = true
+	i.Insert.AllowGenerated = true
+	return i
+}
+
 func (i *insertStatementImpl) ON_CONFLICT(indexExpressions ...jet.ColumnExpression) onConflict {
 	i.OnConflict = onConflictClause{
 		insertStatement:  i,
@@ -75,3 +95,7 @@ func (i *insertStatementImpl) ON_CONFLICT(indexExpressions ...jet.ColumnExpressi
 	}
 	return &i.OnConflict
 }
+
+func (i *insertStatementImpl) ExplainJSON(ctx context.Context, db qrm.DB) (*QueryPlan, error) {
+	return explainJSON(ctx, db, i)
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"database/sql/driver"
 	"github.com/go-jet/jet/v2/internal/jet"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -90,6 +91,110 @@ VALUES ($1, $2),
 	assertStatementSql(t, stmt, expectedSQL, 1, float64(1.11), 1, float64(1.11))
 }
 
+type mood string
+
+const moodHappy mood = "happy"
+
+type priority int
+
+func (p priority) Value() (driver.Value, error) {
+	return int64(p) * 10, nil
+}
+
+func TestInsertValuesFromModelDefinedTypes(t *testing.T) {
+	type Table2Model struct {
+		ColStr mood
+		ColInt priority
+	}
+
+	stmt := table2.INSERT(table2ColStr, table2ColInt).
+		MODEL(Table2Model{ColStr: moodHappy, ColInt: 2}).
+		VALUES(moodHappy, priority(3))
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table2 (col_str, col_int)
+VALUES ($1, $2),
+       ($3, $4);
+`, "happy", priority(2), "happy", priority(3))
+
+	assertDebugStatementSql(t, stmt, `
+INSERT INTO db.table2 (col_str, col_int)
+VALUES ('happy', 20),
+       ('happy', 30);
+`)
+
+	assertStatementSql(t, table2.UPDATE(table2ColStr).SET(moodHappy).WHERE(table2ColInt.EQ(Int(1))), `
+UPDATE db.table2
+SET col_str = $1
+WHERE table2.col_int = $2;
+`, "happy", int64(1))
+}
+
+func TestInsertValuesFromModelAliasTag(t *testing.T) {
+	type Table1Model struct {
+		ID    int     `alias:"table1.col1"`
+		Float float64 `alias:"col_float"`
+	}
+
+	stmt := table1.INSERT(table1Col1, table1ColFloat).
+		MODEL(Table1Model{ID: 1, Float: 1.11})
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float)
+VALUES ($1, $2);
+`, 1, float64(1.11))
+}
+
+func TestInsertValuesFromModelExceptZeroDefaults(t *testing.T) {
+	var (
+		idColumn        = IntegerColumn("id")
+		nameColumn      = StringColumn("name")
+		statusColumn    = StringColumn("status")
+		createdAtColumn = TimestampColumn("created_at")
+		mutableColumns  = ColumnList{nameColumn, statusColumn, createdAtColumn}
+	)
+	SetHasDefault(idColumn)
+	SetHasDefault(statusColumn)
+	SetHasDefault(createdAtColumn)
+
+	jobs := NewTable("db", "jobs", "", idColumn, nameColumn, statusColumn, createdAtColumn)
+
+	type Jobs struct {
+		ID        int32
+		Name      string
+		Status    *string
+		CreatedAt time.Time
+	}
+
+	assertStatementSql(t, jobs.INSERT(mutableColumns.ExceptZeroDefaults(Jobs{})).MODEL(Jobs{}), `
+INSERT INTO db.jobs (name, created_at)
+VALUES ($1, $2);
+`, "", time.Time{})
+
+	type JobStatus struct {
+		Status *string
+	}
+
+	statusColumns := ColumnList{statusColumn}
+
+	assertStatementSql(t, jobs.INSERT(statusColumns.ExceptZeroDefaults(JobStatus{})).MODEL(JobStatus{}), `
+INSERT INTO db.jobs
+DEFAULT VALUES;
+`)
+	assertPanicErr(t, func() {
+		jobs.INSERT(statusColumns.ExceptZeroDefaults(JobStatus{})).MODELS([]JobStatus{{}, {}}).Sql()
+	}, "jet: multiple rows of column default values can not be inserted with DEFAULT VALUES")
+
+	status := ""
+	createdAt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	job := &Jobs{Name: "cleanup", Status: &status, CreatedAt: createdAt}
+
+	assertStatementSql(t, jobs.INSERT(mutableColumns.ExceptZeroDefaults(job)).MODEL(job), `
+INSERT INTO db.jobs (name, status, created_at)
+VALUES ($1, $2, $3);
+`, "cleanup", "", createdAt)
+}
+
 func TestInsertValuesFromModelColumnMismatch(t *testing.T) {
 	defer func() {
 		r := recover()
@@ -174,6 +279,12 @@ RETURNING table1.col1 AS "table1.col1",
 `)
 }
 
+func TestInsert_ON_CONFLICT_DO_UPDATE_NoAssignments(t *testing.T) {
+	assertPanicErr(t, func() {
+		table1.INSERT(table1Col1).VALUES(1).ON_CONFLICT(table1Col1).DO_UPDATE(SET())
+	}, "jet: SET conflict action requires at least one column assignment")
+}
+
 func TestInsert_ON_CONFLICT_ON_CONSTRAINT(t *testing.T) {
 	stmt := table1.INSERT(table1Col1, table1ColBool).
 		VALUES("one", "two").
@@ -199,3 +310,94 @@ RETURNING table1.col1 AS "table1.col1",
           table1.col_bool AS "table1.col_bool";
 `)
 }
+
+func TestInsert_ON_CONFLICT_DO_UPDATE_WHERE_argumentOrder(t *testing.T) {
+	updatedAt := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	stmt := table1.INSERT(table1Col1, table1ColTimestamp).
+		VALUES(1, updatedAt).
+		ON_CONFLICT(table1Col1).DO_UPDATE(
+		SET(table1ColTimestamp.SET(TimestampT(updatedAt))).
+			WHERE(table1ColTimestamp.LT(TimestampT(updatedAt))),
+	)
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_timestamp)
+VALUES ($1, $2)
+ON CONFLICT (col1) DO UPDATE
+       SET col_timestamp = $3::timestamp without time zone
+       WHERE table1.col_timestamp < $4::timestamp without time zone;
+`, 1, updatedAt, updatedAt, updatedAt)
+}
+
+func TestInsertOverridingSystemValue(t *testing.T) {
+	idColumn := IntegerColumn("id")
+	SetGenerated(idColumn)
+	nameColumn := StringColumn("name")
+	identityTable := NewTable("db", "identity_table", "", idColumn, nameColumn)
+
+	assertStatementSql(t, identityTable.INSERT(idColumn, nameColumn).OVERRIDING_SYSTEM_VALUE().VALUES(1, "one"), `
+INSERT INTO db.identity_table (id, name)
+OVERRIDING SYSTEM VALUE
+VALUES ($1, $2);
+`, 1, "one")
+
+	assertStatementSql(t, identityTable.INSERT(idColumn, nameColumn).
+		OVERRIDING_SYSTEM_VALUE().
+		QUERY(SELECT(table1Col1, table1ColFloat).FROM(table1)), `
+INSERT INTO db.identity_table (id, name)
+OVERRIDING SYSTEM VALUE (
+     SELECT table1.col1 AS "table1.col1",
+          table1.col_float AS "table1.col_float"
+     FROM db.table1
+);
+`)
+}
+
+func TestInsertGeneratedColumn(t *testing.T) {
+	idColumn := IntegerColumn("id")
+	computedColumn := StringColumn("computed")
+	SetGenerated(computedColumn)
+	computedTable := NewTable("db", "computed_table", "", idColumn, computedColumn)
+
+	require.True(t, computedColumn.IsGenerated())
+	require.False(t, idColumn.IsGenerated())
+
+	assertStatementSqlErr(t, computedTable.INSERT(idColumn, computedColumn).VALUES(1, "one"),
+		"jet: can't assign value to generated column 'computed'")
+	assertStatementSqlErr(t, computedTable.INSERT(ColumnList{idColumn, computedColumn}).VALUES(1, "one"),
+		"jet: can't assign value to generated column 'computed'")
+
+	assertStatementSql(t, computedTable.INSERT(idColumn).VALUES(1), `
+INSERT INTO db.computed_table (id)
+VALUES ($1);
+`, 1)
+}
+
+func TestInsertNULL(t *testing.T) {
+	assertStatementSql(t, table1.INSERT(table1Col1, table1ColFloat).VALUES(NULL, CAST(NULL).AS_DOUBLE()), `
+INSERT INTO db.table1 (col1, col_float)
+VALUES (NULL, NULL::double precision);
+`)
+}
+
+func TestInsertUpdateDeleteReturningStar(t *testing.T) {
+	assertStatementSql(t, table1.INSERT(table1Col1).VALUES(1).VALUES(2).RETURNING(STAR), `
+INSERT INTO db.table1 (col1)
+VALUES ($1),
+       ($2)
+RETURNING *;
+`, 1, 2)
+	assertStatementSql(t, table1.UPDATE(table1Col1).SET(1).WHERE(table1ColInt.EQ(Int(2))).RETURNING(STAR), `
+UPDATE db.table1
+SET col1 = $1
+WHERE table1.col_int = $2
+RETURNING *;
+`, 1, int64(2))
+	assertStatementSql(t, table1.DELETE().WHERE(table1ColInt.EQ(Int(2))).RETURNING(table1Col1, STAR), `
+DELETE FROM db.table1
+WHERE table1.col_int = $1
+RETURNING table1.col1 AS "table1.col1",
+          *;
+`, int64(2))
+}
@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"github.com/go-jet/jet/v2/internal/utils"
+)
+
+// Interval is model type for PostgreSQL interval values. Months, days and microseconds are kept separately, the same
+// way database stores intervals, so interval values round-trip without loss. Generated models use Interval for
+// interval columns by default.
+type Interval struct {
+	Months       int32
+	Days         int32
+	Microseconds int64
+}
+
+// IntervalFromDuration creates new Interval from duration. Duration is truncated to microseconds.
+func IntervalFromDuration(duration time.Duration) Interval {
+	return Interval{Microseconds: int64(duration / time.Microsecond)}
+}
+
+// Duration returns interval as time.Duration. Conversion is lossless only for pure time intervals, so false is
+// returned if interval has months or days component.
+func (i Interval) Duration() (time.Duration, bool) {
+	if i.Months != 0 || i.Days != 0 {
+		return 0, false
+	}
+
+	return time.Duration(i.Microseconds) * time.Microsecond, true
+}
+
+// Scan implements the Scanner interface.
+func (i *Interval) Scan(value interface{}) error {
+	var text string
+
+	switch v := value.(type) {
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		return fmt.Errorf("jet: can't scan interval from %T", value)
+	}
+
+	months, days, microseconds, err := utils.ParseInterval(text)
+
+	if err != nil {
+		return fmt.Errorf("jet: %w", err)
+	}
+
+	*i = Interval{Months: months, Days: days, Microseconds: microseconds}
+
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (i Interval) Value() (driver.Value, error) {
+	return fmt.Sprintf("%d mons %d days %d microseconds", i.Months, i.Days, i.Microseconds), nil
+}
@@ -182,6 +182,11 @@ func INTERVALd(duration time.Duration) IntervalExpression {
 	return INTERVAL(quantityAndUnits...)
 }
 
+// IntervalT creates interval expression from Interval model value
+func IntervalT(interval Interval) IntervalExpression {
+	return IntervalExp(CAST(jet.Literal(interval)).AS("interval"))
+}
+
 func unitToString(unit quantityAndUnit) string {
 	switch unit {
 	case YEAR:
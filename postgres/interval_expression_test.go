@@ -43,6 +43,12 @@ func TestINTERVALd(t *testing.T) {
 		"INTERVAL '1 DAY 2 HOUR 3 MINUTE 4 SECOND 5 MICROSECOND'")
 }
 
+func TestIntervalT(t *testing.T) {
+	interval := Interval{Months: 1, Days: 2, Microseconds: 3}
+	assertSerialize(t, IntervalT(interval), "$1::interval", interval)
+	assertDebugSerialize(t, IntervalT(interval), "'1 mons 2 days 3 microseconds'::interval")
+}
+
 func TestINTERVAL_InvalidParams(t *testing.T) {
 	assertPanicErr(t, func() { INTERVAL() }, "jet: invalid number of quantity and unit fields")
 	assertPanicErr(t, func() { INTERVAL(1) }, "jet: invalid number of quantity and unit fields")
@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestIntervalScanAndValue(t *testing.T) {
+	var interval Interval
+
+	require.NoError(t, interval.Scan("1 year 2 mons 3 days 04:05:06.5"))
+	require.Equal(t, Interval{Months: 14, Days: 3, Microseconds: 14706500000}, interval)
+
+	value, err := interval.Value()
+	require.NoError(t, err)
+	require.Equal(t, "14 mons 3 days 14706500000 microseconds", value)
+
+	_, ok := interval.Duration()
+	require.False(t, ok)
+
+	require.NoError(t, interval.Scan([]byte("-01:30:00")))
+	duration, ok := interval.Duration()
+	require.True(t, ok)
+	require.Equal(t, -90*time.Minute, duration)
+
+	require.Equal(t, Interval{Microseconds: 1500000}, IntervalFromDuration(1500*time.Millisecond+999))
+
+	require.EqualError(t, interval.Scan(int64(1)), "jet: can't scan interval from int64")
+	require.EqualError(t, interval.Scan("1 fortnight"), "jet: invalid interval '1 fortnight', unsupported unit 'fortnight'")
+}
@@ -8,7 +8,7 @@ const (
 )
 
 var (
-	// NULL is jet equivalent of SQL NULL
+	// NULL is jet equivalent of SQL NULL. It can be typed using CAST when needed, for instance CAST(NULL).AS_INTEGER()
 	NULL = jet.NULL
 	// STAR is jet equivalent of SQL *
 	STAR = jet.STAR
@@ -12,3 +12,39 @@ func TestLATERAL(t *testing.T) {
      SELECT $1
 ) AS lat1`)
 }
+
+func TestLeftJoinLATERAL(t *testing.T) {
+	topRows := LATERAL(
+		SELECT(table2Col3, table2ColInt).
+			FROM(table2).
+			WHERE(table2ColInt.EQ(table1ColInt)).
+			ORDER_BY(table2Col3.DESC()).
+			LIMIT(3),
+	).AS("top_rows")
+
+	topCol3 := table2Col3.From(topRows)
+
+	assertDebugStatementSql(t, SELECT(table1Col1, topCol3).
+		FROM(table1.LEFT_JOIN(topRows, Bool(true))).
+		WHERE(topCol3.GT(table1Col1)), `
+SELECT table1.col1 AS "table1.col1",
+     top_rows."table2.col3" AS "table2.col3"
+FROM db.table1
+     LEFT JOIN LATERAL (
+          SELECT table2.col3 AS "table2.col3",
+               table2.col_int AS "table2.col_int"
+          FROM db.table2
+          WHERE table2.col_int = table1.col_int
+          ORDER BY table2.col3 DESC
+          LIMIT 3
+     ) AS top_rows ON TRUE::boolean
+WHERE top_rows."table2.col3" > table1.col1;
+`)
+
+	assertPanicErr(t, func() {
+		LATERAL(nil).AS("top_rows")
+	}, "jet: LATERAL sub-query is nil")
+	assertPanicErr(t, func() {
+		LATERAL(SELECT(table2Col3).FROM(table2)).AS("")
+	}, "jet: LATERAL sub-query alias is empty")
+}
@@ -8,6 +8,7 @@ import (
 
 func TestBool(t *testing.T) {
 	assertSerialize(t, Bool(false), `$1::boolean`, false)
+	assertDebugSerialize(t, Bool(true), `TRUE::boolean`)
 }
 
 func TestInt(t *testing.T) {
@@ -1,9 +1,11 @@
 package postgres
 
 import (
+	"context"
 	"math"
 
 	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
 )
 
 // RowLock is interface for SELECT statement row lock types
@@ -25,6 +27,13 @@ var (
 	CURRENT_ROW  = jet.CURRENT_ROW
 )
 
+// Window frame exclusions
+var (
+	GROUP     = jet.GROUP
+	TIES      = jet.TIES
+	NO_OTHERS = jet.NO_OTHERS
+)
+
 // PRECEDING window frame clause
 func PRECEDING(offset int64) jet.FrameExtent {
 	return jet.PRECEDING(toJetFrameOffset(offset))
@@ -48,13 +57,26 @@ type SelectStatement interface {
 	FROM(tables ...ReadableTable) SelectStatement
 	WHERE(expression BoolExpression) SelectStatement
 	GROUP_BY(groupByClauses ...GroupByClause) SelectStatement
+	// GROUP_BY_ALL groups by all select list projections which are not aggregate or window function calls.
+	// Projections are expanded into GROUP BY list, because GROUP BY ALL is not supported by the database.
+	GROUP_BY_ALL() SelectStatement
 	HAVING(boolExpression BoolExpression) SelectStatement
+	// AppendHaving ANDs boolExpression to existing HAVING condition, instead of replacing it.
+	// HAVING clause is created if it does not exist, and nil boolExpression is ignored.
+	AppendHaving(boolExpression BoolExpression) SelectStatement
 	WINDOW(name string) windowExpand
 	ORDER_BY(orderByClauses ...OrderByClause) SelectStatement
+	// AppendOrderBy appends order by clauses to existing ORDER BY clause, instead of replacing it.
+	// Clauses ordering by columns already in ORDER BY clause are skipped.
+	AppendOrderBy(orderByClauses ...OrderByClause) SelectStatement
 	LIMIT(limit int64) SelectStatement
+	LIMIT_ALL() SelectStatement
 	OFFSET(offset int64) SelectStatement
 	FOR(lock RowLock) SelectStatement
 
+	// ExplainJSON executes EXPLAIN (FORMAT JSON) of the statement over db connection/transaction and returns parsed execution plan
+	ExplainJSON(ctx context.Context, db qrm.DB) (*QueryPlan, error)
+
 	UNION(rhs SelectStatement) setStatement
 	UNION_ALL(rhs SelectStatement) setStatement
 	INTERSECT(rhs SelectStatement) setStatement
@@ -62,14 +84,50 @@ type SelectStatement interface {
 	EXCEPT(rhs SelectStatement) setStatement
 	EXCEPT_ALL(rhs SelectStatement) setStatement
 
+	// Filter filters select statement rows by the result of window function. Select statement without ORDER BY, LIMIT
+	// and OFFSET, with window function result added to the projection list, is wrapped in a sub-query, and outer query
+	// applies condition, followed by ORDER BY, LIMIT and OFFSET of the statement. Condition is constructed with reference
+	// to window function result, which can be cast to the window function type with IntExp, FloatExp or similar.
+	// Useful for "top N per group" queries. Statement itself is not modified.
+	Filter(windowFunction Expression, condition func(window Expression) BoolExpression) SelectStatement
+
 	AsTable(alias string) SelectTable
+	// AsCount creates new SELECT statement which counts rows returned by this statement:
+	// SELECT COUNT(*) AS "count" FROM (<this statement without ORDER BY, LIMIT, OFFSET and row locks>) AS t.
+	// Can be used to get total number of rows for pagination, without repeating query filters.
+	AsCount() SelectStatement
+
+	// Validate checks that every column referenced in the statement belongs to a table, sub-query or CTE in the
+	// statement FROM clause. Returned error names the first column whose table is not in scope.
+	Validate() error
 }
 
+// NormalizeNullOrdering sets explicit null ordering to all the order by clauses without one, so that the order of
+// the result does not depend on database defaults. NULL values are sorted last in ascending, and first in descending order.
+var NormalizeNullOrdering = jet.NormalizeNullOrdering
+
 //SELECT creates new SelectStatement with list of projections
 func SELECT(projection Projection, projections ...Projection) SelectStatement {
 	return newSelectStatement(nil, append([]Projection{projection}, projections...))
 }
 
+// ExistsCount creates new SelectStatement that checks if at least one row of the table satisfies condition:
+// SELECT EXISTS (SELECT 1 FROM table WHERE condition). It is usually faster alternative to counting all the rows
+// when only a boolean result is needed.
+func ExistsCount(table ReadableTable, condition BoolExpression) SelectStatement {
+	return SELECT(
+		EXISTS(SELECT(jet.FixedLiteral(1)).FROM(table).WHERE(condition)).AS("exists"),
+	)
+}
+
+// ScopedSelect creates new SelectStatement from table, whose WHERE condition is always combined with scope condition.
+// Generated table SQL builders use it to exclude soft deleted rows.
+func ScopedSelect(table ReadableTable, scope BoolExpression, projections ...Projection) SelectStatement {
+	newSelect := newSelectStatement(table, projections).(*selectStatementImpl)
+	newSelect.Where.Scope = scope
+	return newSelect
+}
+
 func newSelectStatement(table ReadableTable, projections []Projection) SelectStatement {
 	newSelect := &selectStatementImpl{}
 	newSelect.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, newSelect, &newSelect.Select,
@@ -77,9 +135,12 @@ func newSelectStatement(table ReadableTable, projections []Projection) SelectSta
 		&newSelect.Limit, &newSelect.Offset, &newSelect.For)
 
 	newSelect.Select.ProjectionList = projections
+	newSelect.Select.orderBy = &newSelect.OrderBy
 	if table != nil {
 		newSelect.From.Tables = []jet.Serializer{table}
 	}
+	newSelect.OrderBy.Select = &newSelect.Select.ClauseSelect
+	newSelect.OrderBy.GroupBy = &newSelect.GroupBy
 	newSelect.Limit.Count = -1
 	newSelect.Offset.Count = -1
 
@@ -92,7 +153,7 @@ type selectStatementImpl struct {
 	jet.ExpressionStatement
 	setOperatorsImpl
 
-	Select  jet.ClauseSelect
+	Select  clauseSelect
 	From    jet.ClauseFrom
 	Where   jet.ClauseWhere
 	GroupBy jet.ClauseGroupBy
@@ -122,6 +183,13 @@ func (s *selectStatementImpl) WHERE(condition BoolExpression) SelectStatement {
 
 func (s *selectStatementImpl) GROUP_BY(groupByClauses ...GroupByClause) SelectStatement {
 	s.GroupBy.List = groupByClauses
+	s.GroupBy.All = nil
+	return s
+}
+
+func (s *selectStatementImpl) GROUP_BY_ALL() SelectStatement {
+	s.GroupBy.List = nil
+	s.GroupBy.All = &s.Select.ClauseSelect
 	return s
 }
 
@@ -130,6 +198,11 @@ func (s *selectStatementImpl) HAVING(boolExpression BoolExpression) SelectStatem
 	return s
 }
 
+func (s *selectStatementImpl) AppendHaving(boolExpression BoolExpression) SelectStatement {
+	s.Having.Append(boolExpression)
+	return s
+}
+
 func (s *selectStatementImpl) WINDOW(name string) windowExpand {
 	s.Window.Definitions = append(s.Window.Definitions, jet.WindowDefinition{Name: name})
 	return windowExpand{selectStatement: s}
@@ -140,8 +213,20 @@ func (s *selectStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) SelectSt
 	return s
 }
 
+func (s *selectStatementImpl) AppendOrderBy(orderByClauses ...OrderByClause) SelectStatement {
+	s.OrderBy.Append(orderByClauses...)
+	return s
+}
+
 func (s *selectStatementImpl) LIMIT(limit int64) SelectStatement {
 	s.Limit.Count = limit
+	s.Limit.All = false
+	return s
+}
+
+func (s *selectStatementImpl) LIMIT_ALL() SelectStatement {
+	s.Limit.Count = -1
+	s.Limit.All = true
 	return s
 }
 
@@ -159,6 +244,69 @@ func (s *selectStatementImpl) AsTable(alias string) SelectTable {
 	return newSelectTable(s, alias)
 }
 
+func (s *selectStatementImpl) AsCount() SelectStatement {
+	countFrom := newSelectStatement(nil, nil).(*selectStatementImpl)
+	countFrom.Select.ClauseSelect = s.Select.ClauseSelect
+	countFrom.From = s.From
+	countFrom.Where = s.Where
+	countFrom.GroupBy = s.GroupBy
+	countFrom.Having = s.Having
+	countFrom.Window = s.Window
+
+	return SELECT(COUNT(STAR).AS("count")).FROM(countFrom.AsTable("t"))
+}
+
+func (s *selectStatementImpl) Validate() error {
+	return jet.ValidateColumnScope(Dialect, s)
+}
+
+func (s *selectStatementImpl) Filter(windowFunction Expression, condition func(window Expression) BoolExpression) SelectStatement {
+	inner := newSelectStatement(nil, nil).(*selectStatementImpl)
+	inner.Select.ClauseSelect = s.Select.ClauseSelect
+	inner.Select.ProjectionList = append(append([]Projection{}, s.Select.ProjectionList...), windowFunction.AS(filterWindowAlias))
+	inner.From = s.From
+	inner.Where = s.Where
+	inner.GroupBy = s.GroupBy
+	inner.Having = s.Having
+	inner.Window = s.Window
+	inner.For = s.For
+
+	orderProjections := 0
+
+	if len(s.OrderBy.List) > 0 { // row order is kept as row number, by which outer query is ordered
+		inner.Select.ProjectionList = append(inner.Select.ProjectionList, ROW_NUMBER().OVER(ORDER_BY(s.OrderBy.List...)).AS(filterOrderAlias))
+		orderProjections = 1
+	}
+
+	filtered := inner.AsTable(filterSubQueryAlias)
+	filteredProjections := filtered.AllColumns()
+	projectionsCount := len(filteredProjections) - 1 - orderProjections
+	window := filteredProjections[projectionsCount].(Expression)
+
+	outer := SELECT(filteredProjections[:projectionsCount]).
+		FROM(filtered).
+		WHERE(condition(window)).(*selectStatementImpl)
+
+	if orderProjections > 0 {
+		outer.OrderBy.List = []OrderByClause{filteredProjections[projectionsCount+1].(Expression).ASC()}
+	}
+
+	outer.Limit = s.Limit
+	outer.Offset = s.Offset
+
+	return outer
+}
+
+func (s *selectStatementImpl) ExplainJSON(ctx context.Context, db qrm.DB) (*QueryPlan, error) {
+	return explainJSON(ctx, db, s)
+}
+
+const (
+	filterSubQueryAlias = "filtered"
+	filterWindowAlias   = "filter_window"
+	filterOrderAlias    = "filter_order"
+)
+
 //-----------------------------------------------------
 
 type windowExpand struct {
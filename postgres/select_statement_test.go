@@ -1,6 +1,10 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
@@ -68,6 +72,31 @@ WHERE table1.col_int >= $1;
 `, int64(10))
 }
 
+func TestSelectWhereEmptyConditionGroup(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt).FROM(table1).WHERE(OR(AND(), AND(OR()))), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1;
+`)
+	assertStatementSql(t, SELECT(table1ColInt).FROM(table1).GROUP_BY(table1ColInt).HAVING(AND(OR(AND()))), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+GROUP BY table1.col_int;
+`)
+	assertStatementSql(t, SELECT(table1ColInt).FROM(table1).WHERE(OR(AND(table1ColInt.GT(Int(1)), AND()), OR())), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE (table1.col_int > $1);
+`, int64(1))
+	assertStatementSql(t, SELECT(table1ColInt).FROM(table1).WHERE(NOT(AND(OR()))), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE NOT TRUE;
+`)
+
+	_, err := table1.DELETE().WHERE(AND(OR())).Exec(&queryRecorderDB{})
+	require.True(t, errors.Is(err, ErrMissingWhere))
+}
+
 func TestSelectGroupBy(t *testing.T) {
 	assertStatementSql(t, SELECT(table2ColInt).FROM(table2).GROUP_BY(table2ColFloat), `
 SELECT table2.col_int AS "table2.col_int"
@@ -97,6 +126,151 @@ ORDER BY table2.col_int DESC, table2.col_int ASC;
 `)
 }
 
+func TestSelectAppendHaving(t *testing.T) {
+	baseQuery := func() SelectStatement {
+		return SELECT(table2ColInt, COUNT(table2ColFloat)).FROM(table2).GROUP_BY(table2ColInt)
+	}
+
+	assertStatementSql(t, baseQuery().AppendHaving(COUNT(table2ColFloat).GT(Int(10))), `
+SELECT table2.col_int AS "table2.col_int",
+     COUNT(table2.col_float)
+FROM db.table2
+GROUP BY table2.col_int
+HAVING COUNT(table2.col_float) > $1;
+`, int64(10))
+	assertStatementSql(t, baseQuery().
+		HAVING(COUNT(table2ColFloat).GT(Int(10))).
+		AppendHaving(nil).
+		AppendHaving(MAXf(table2ColFloat).LT(Float(1.5))), `
+SELECT table2.col_int AS "table2.col_int",
+     COUNT(table2.col_float)
+FROM db.table2
+GROUP BY table2.col_int
+HAVING (COUNT(table2.col_float) > $1) AND (MAX(table2.col_float) < $2);
+`, int64(10), 1.5)
+}
+
+func TestSelectValidate(t *testing.T) {
+	require.NoError(t, SELECT(table1Col1, table2Col3).
+		FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))).
+		WHERE(table1ColBool.IS_TRUE()).
+		ORDER_BY(table2ColFloat).
+		Validate())
+
+	subQuery := SELECT(table3Col1).FROM(table3).AsTable("sub_query")
+	subQueryCol1 := table3Col1.From(subQuery)
+	require.NoError(t, SELECT(table1Col1, subQueryCol1).
+		FROM(table1.CROSS_JOIN(subQuery)).
+		WHERE(table1ColInt.IN(SELECT(table2ColInt).FROM(table2).WHERE(table2Col3.EQ(table1Col1)))).
+		Validate())
+
+	require.EqualError(t, SELECT(table1Col1).FROM(table1).WHERE(table2Col3.EQ(Int(1))).Validate(),
+		"jet: column 'table2.col3' references table 'table2', which is not in the FROM clause")
+	require.EqualError(t, SELECT(table1Col1).FROM(table1).ORDER_BY(subQueryCol1).Validate(),
+		"jet: column 'sub_query.table3.col1' references table 'sub_query', which is not in the FROM clause")
+	require.EqualError(t, SELECT(table3Col1).Validate(),
+		"jet: column 'table3.col1' references table 'table3', which is not in the FROM clause")
+	require.EqualError(t, SELECT(table1Col1, table2Col3).
+		FROM(table1).
+		WHERE(EXISTS(SELECT(Int(1)).FROM(table2))).
+		Validate(),
+		"jet: column 'table2.col3' references table 'table2', which is not in the FROM clause")
+}
+
+func TestSelectJoinSubQuery(t *testing.T) {
+	totals := SELECT(table2ColInt, SUM(table2ColFloat).AS("total")).
+		FROM(table2).
+		GROUP_BY(table2ColInt).
+		AsTable("totals")
+
+	totalsColInt := table2ColInt.From(totals)
+	total := FloatColumn("total").From(totals)
+
+	assertDebugStatementSql(t, SELECT(table1Col1, total).
+		FROM(table1.INNER_JOIN(totals, totalsColInt.EQ(table1ColInt))).
+		WHERE(total.GT(Float(10))), `
+SELECT table1.col1 AS "table1.col1",
+     totals.total AS "total"
+FROM db.table1
+     INNER JOIN (
+          SELECT table2.col_int AS "table2.col_int",
+               SUM(table2.col_float) AS "total"
+          FROM db.table2
+          GROUP BY table2.col_int
+     ) AS totals ON (totals."table2.col_int" = table1.col_int)
+WHERE totals.total > 10;
+`)
+
+	assertPanicErr(t, func() {
+		SELECT(table2ColInt).FROM(table2).AsTable("")
+	}, "jet: sub-query alias is empty")
+}
+
+func TestSelectAppendOrderBy(t *testing.T) {
+	baseQuery := func() SelectStatement {
+		return SELECT(table2ColFloat).FROM(table2).ORDER_BY(table2ColInt.DESC())
+	}
+
+	assertStatementSql(t, baseQuery().AppendOrderBy(table2ColFloat.ASC(), table2ColInt.ASC(), table2Col3), `
+SELECT table2.col_float AS "table2.col_float"
+FROM db.table2
+ORDER BY table2.col_int DESC, table2.col_float ASC, table2.col3;
+`)
+	assertStatementSql(t, baseQuery().AppendOrderBy(table2ColFloat.DESC()).AppendOrderBy(table2ColFloat, table2ColInt.ADD(Int(1)).ASC()), `
+SELECT table2.col_float AS "table2.col_float"
+FROM db.table2
+ORDER BY table2.col_int DESC, table2.col_float DESC, table2.col_int + $1 ASC;
+`, int64(1))
+	assertStatementSql(t, SELECT(table2ColFloat).FROM(table2).AppendOrderBy(table2ColInt.DESC()), `
+SELECT table2.col_float AS "table2.col_float"
+FROM db.table2
+ORDER BY table2.col_int DESC;
+`)
+}
+
+func TestSelectDistinctOnOrderBy(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt, table1ColFloat).DISTINCT(table1ColInt).FROM(table1), `
+SELECT DISTINCT ON (table1.col_int) table1.col_int AS "table1.col_int",
+     table1.col_float AS "table1.col_float"
+FROM db.table1;
+`)
+	assertStatementSql(t, SELECT(table1ColInt, table1ColFloat).DISTINCT(table1ColInt, table1ColBool).FROM(table1).
+		ORDER_BY(table1ColBool.ASC(), table1ColInt.DESC(), table1ColFloat.DESC()), `
+SELECT DISTINCT ON (table1.col_int, table1.col_bool) table1.col_int AS "table1.col_int",
+     table1.col_float AS "table1.col_float"
+FROM db.table1
+ORDER BY table1.col_bool ASC, table1.col_int DESC, table1.col_float DESC;
+`)
+	assertStatementSql(t, SELECT(table1ColInt).DISTINCT(table1ColInt, table1ColBool).FROM(table1).ORDER_BY(table1ColInt), `
+SELECT DISTINCT ON (table1.col_int, table1.col_bool) table1.col_int AS "table1.col_int"
+FROM db.table1
+ORDER BY table1.col_int;
+`)
+
+	assertStatementSqlErr(t, SELECT(table1ColInt).DISTINCT(table1ColInt).FROM(table1).ORDER_BY(table1ColFloat.DESC(), table1ColInt),
+		"jet: DISTINCT ON column 'table1.col_int' has to match one of the leftmost ORDER BY expressions, ORDER BY has to start with DISTINCT ON columns")
+	assertStatementSqlErr(t, SELECT(table1ColInt).DISTINCT(table1ColInt, table1ColBool).FROM(table1).
+		ORDER_BY(table1ColInt, table1ColFloat, table1ColBool),
+		"jet: DISTINCT ON column 'table1.col_bool' has to match one of the leftmost ORDER BY expressions, ORDER BY has to start with DISTINCT ON columns")
+	assertStatementSqlErr(t, SELECT(table1ColInt).DISTINCT(table1ColInt).FROM(table1).ORDER_BY(table1ColInt.ADD(Int(1))),
+		"jet: DISTINCT ON column 'table1.col_int' has to match one of the leftmost ORDER BY expressions, ORDER BY has to start with DISTINCT ON columns")
+}
+
+func TestSelectOrderByNulls(t *testing.T) {
+	assertStatementSql(t, SELECT(table2ColFloat).FROM(table2).ORDER_BY(table2ColInt.DESC().NULLS_LAST(), table2ColFloat.NULLS_FIRST()), `
+SELECT table2.col_float AS "table2.col_float"
+FROM db.table2
+ORDER BY table2.col_int DESC NULLS LAST, table2.col_float ASC NULLS FIRST;
+`)
+	assertStatementSql(t, SELECT(table2ColFloat).FROM(table2).ORDER_BY(
+		NormalizeNullOrdering(table2ColInt.DESC(), table2ColFloat.ASC(), table2ColStr, table2ColBool.ASC().NULLS_FIRST())...,
+	), `
+SELECT table2.col_float AS "table2.col_float"
+FROM db.table2
+ORDER BY table2.col_int DESC NULLS FIRST, table2.col_float ASC NULLS LAST, table2.col_str ASC NULLS LAST, table2.col_bool ASC NULLS FIRST;
+`)
+}
+
 func TestSelectLimitOffset(t *testing.T) {
 	assertStatementSql(t, SELECT(table2ColInt).FROM(table2).LIMIT(10), `
 SELECT table2.col_int AS "table2.col_int"
@@ -109,6 +283,18 @@ FROM db.table2
 LIMIT $1
 OFFSET $2;
 `, int64(10), int64(2))
+
+	assertStatementSql(t, SELECT(table2ColInt).FROM(table2).LIMIT_ALL().OFFSET(20), `
+SELECT table2.col_int AS "table2.col_int"
+FROM db.table2
+LIMIT ALL
+OFFSET $1;
+`, int64(20))
+	assertStatementSql(t, SELECT(table2ColInt).FROM(table2).LIMIT_ALL().LIMIT(10), `
+SELECT table2.col_int AS "table2.col_int"
+FROM db.table2
+LIMIT $1;
+`, int64(10))
 }
 
 func TestSelectLock(t *testing.T) {
@@ -134,3 +320,454 @@ FROM db.table1
 FOR NO KEY UPDATE SKIP LOCKED;
 `)
 }
+
+func TestExistsCount(t *testing.T) {
+	assertStatementSql(t, ExistsCount(table1, table1ColInt.EQ(Int(11))), `
+SELECT (EXISTS (
+          SELECT 1
+          FROM db.table1
+          WHERE table1.col_int = $1
+     )) AS "exists";
+`, int64(11))
+}
+
+func TestSelectCrossTableColumnComparison(t *testing.T) {
+	stmt := SELECT(table1ColInt, table2ColInt).
+		FROM(table1.INNER_JOIN(table2, table1ColFloat.LT(table2ColFloat))).
+		WHERE(table1ColInt.GT(table2ColInt)).
+		GROUP_BY(table1ColInt, table2ColInt).
+		HAVING(MAXf(table1ColFloat).GT(MINf(table2ColFloat)))
+
+	assertStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int",
+     table2.col_int AS "table2.col_int"
+FROM db.table1
+     INNER JOIN db.table2 ON (table1.col_float < table2.col_float)
+WHERE table1.col_int > table2.col_int
+GROUP BY table1.col_int, table2.col_int
+HAVING MAX(table1.col_float) > MIN(table2.col_float);
+`)
+
+	_, args := stmt.Sql()
+	require.Empty(t, args)
+}
+
+func TestSelectCorrelatedSubQuery(t *testing.T) {
+	innerCol1 := IntegerColumn("col1")
+	innerColInt := IntegerColumn("col_int")
+	inner := NewTable("db", "table1", "inner_table1", innerCol1, innerColInt)
+
+	stmt := SELECT(
+		table1Col1,
+		IntExp(SELECT(MAX(innerColInt)).FROM(inner).WHERE(innerCol1.LT(table1Col1))).AS("prev_col_int"),
+	).FROM(
+		table1,
+	).WHERE(
+		EXISTS(
+			SELECT(table2ColInt).
+				FROM(table2).
+				WHERE(table2ColInt.EQ(table1ColInt)),
+		),
+	)
+
+	assertDebugStatementSql(t, stmt, `
+SELECT table1.col1 AS "table1.col1",
+     (
+          SELECT MAX(inner_table1.col_int)
+          FROM db.table1 AS inner_table1
+          WHERE inner_table1.col1 < table1.col1
+     ) AS "prev_col_int"
+FROM db.table1
+WHERE EXISTS (
+           SELECT table2.col_int AS "table2.col_int"
+           FROM db.table2
+           WHERE table2.col_int = table1.col_int
+      );
+`)
+}
+
+func TestSelectFilter(t *testing.T) {
+	stmt := SELECT(table1ColInt, table1ColFloat).
+		FROM(table1).
+		WHERE(table1ColBool.EQ(Bool(true))).
+		Filter(ROW_NUMBER().OVER(PARTITION_BY(table1ColInt).ORDER_BY(table1ColFloat.DESC())),
+			func(window Expression) BoolExpression {
+				return IntExp(window).LT_EQ(Int(3))
+			})
+
+	assertStatementSql(t, stmt, `
+SELECT filtered."table1.col_int" AS "table1.col_int",
+     filtered."table1.col_float" AS "table1.col_float"
+FROM (
+          SELECT table1.col_int AS "table1.col_int",
+               table1.col_float AS "table1.col_float",
+               ROW_NUMBER() OVER (PARTITION BY table1.col_int ORDER BY table1.col_float DESC) AS "filter_window"
+          FROM db.table1
+          WHERE table1.col_bool = $1::boolean
+     ) AS filtered
+WHERE filtered.filter_window <= $2;
+`, true, int64(3))
+}
+
+func TestSelectFilterOrderByLimit(t *testing.T) {
+	stmt := SELECT(table1ColInt, table1ColFloat).
+		FROM(table1).
+		ORDER_BY(table1ColInt, table1ColFloat.DESC()).
+		LIMIT(5).
+		OFFSET(10).
+		Filter(ROW_NUMBER().OVER(PARTITION_BY(table1ColInt).ORDER_BY(table1ColFloat.DESC())),
+			func(window Expression) BoolExpression {
+				return IntExp(window).EQ(Int(1))
+			})
+
+	assertDebugStatementSql(t, stmt, `
+SELECT filtered."table1.col_int" AS "table1.col_int",
+     filtered."table1.col_float" AS "table1.col_float"
+FROM (
+          SELECT table1.col_int AS "table1.col_int",
+               table1.col_float AS "table1.col_float",
+               ROW_NUMBER() OVER (PARTITION BY table1.col_int ORDER BY table1.col_float DESC) AS "filter_window",
+               ROW_NUMBER() OVER (ORDER BY table1.col_int, table1.col_float DESC) AS "filter_order"
+          FROM db.table1
+     ) AS filtered
+WHERE filtered.filter_window = 1
+ORDER BY filter_order ASC
+LIMIT 5
+OFFSET 10;
+`)
+}
+
+func TestSelectFilterDoesNotModifyStatement(t *testing.T) {
+	stmt := SELECT(table1ColInt).FROM(table1)
+	percentRank := PERCENT_RANK().OVER(ORDER_BY(table1ColFloat))
+	topPercent := func(window Expression) BoolExpression {
+		return FloatExp(window).LT(Float(0.1))
+	}
+
+	expectedSQL := `
+SELECT filtered."table1.col_int" AS "table1.col_int"
+FROM (
+          SELECT table1.col_int AS "table1.col_int",
+               PERCENT_RANK() OVER (ORDER BY table1.col_float) AS "filter_window"
+          FROM db.table1
+     ) AS filtered
+WHERE filtered.filter_window < $1;
+`
+	assertStatementSql(t, stmt.Filter(percentRank, topPercent), expectedSQL, 0.1)
+	assertStatementSql(t, stmt.Filter(percentRank, topPercent), expectedSQL, 0.1)
+	assertStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1;
+`)
+}
+
+func TestSelectWindowFrameExclusion(t *testing.T) {
+	stmt := SELECT(
+		AVG(table1ColFloat).OVER(ORDER_BY(table1ColInt).ROWS(PRECEDING(2), FOLLOWING(2)).EXCLUDE(CURRENT_ROW)),
+	).FROM(table1)
+
+	assertStatementSql(t, stmt, `
+SELECT AVG(table1.col_float) OVER (ORDER BY table1.col_int ROWS BETWEEN 2 PRECEDING AND 2 FOLLOWING EXCLUDE CURRENT ROW)
+FROM db.table1;
+`)
+}
+
+func TestSelectWindowPartitionOrderFrame(t *testing.T) {
+	stmt := SELECT(
+		table1ColInt,
+		ROW_NUMBER().OVER(PARTITION_BY(table1ColInt, table1ColBool).ORDER_BY(table1ColFloat.DESC())).SUB(Int(1)).AS("row_index"),
+		SUMf(table1ColFloat).OVER(PARTITION_BY(table1ColInt).ORDER_BY(table1ColFloat).ROWS(PRECEDING(UNBOUNDED), CURRENT_ROW)).AS("running_total"),
+		COUNT(table1ColInt).OVER().AS("total_count"),
+	).FROM(table1)
+
+	assertDebugStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int",
+     (ROW_NUMBER() OVER (PARTITION BY table1.col_int, table1.col_bool ORDER BY table1.col_float DESC) - 1) AS "row_index",
+     SUM(table1.col_float) OVER (PARTITION BY table1.col_int ORDER BY table1.col_float ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) AS "running_total",
+     COUNT(table1.col_int) OVER () AS "total_count"
+FROM db.table1;
+`)
+}
+
+func TestSelectAggregateFilterOverWindow(t *testing.T) {
+	stmt := SELECT(
+		SUMf(table1ColFloat).FILTER(table1ColBool.EQ(Bool(true))).OVER(PARTITION_BY(table1ColInt)).AS("filtered_sum"),
+		COUNT_STAR().FILTER(table1ColFloat.GT(Float(1.5))).AS("filtered_count"),
+	).FROM(table1).
+		GROUP_BY(table1ColInt, table1ColFloat)
+
+	assertStatementSql(t, stmt, `
+SELECT SUM(table1.col_float) FILTER (WHERE table1.col_bool = $1::boolean) OVER (PARTITION BY table1.col_int) AS "filtered_sum",
+     COUNT(*) FILTER (WHERE table1.col_float > $2) AS "filtered_count"
+FROM db.table1
+GROUP BY table1.col_int, table1.col_float;
+`, true, 1.5)
+}
+
+func TestSelectInlineParameters(t *testing.T) {
+	stmt := SELECT(table1ColInt, String("O'Reilly").AS("name")).
+		FROM(table1).
+		WHERE(table1ColInt.GT(Int(10)).AND(table1ColBool.EQ(Bool(true))))
+
+	query, args := stmt.InlineParameters().Sql()
+
+	require.Equal(t, query, `
+SELECT table1.col_int AS "table1.col_int",
+     'O''Reilly' AS "name"
+FROM db.table1
+WHERE (table1.col_int > 10) AND (table1.col_bool = TRUE::boolean);
+`)
+	require.Len(t, args, 0)
+
+	_, args = stmt.Sql()
+	require.Len(t, args, 3)
+}
+
+func TestScopedSelect(t *testing.T) {
+	assertStatementSql(t, ScopedSelect(table1, table1ColTimestamp.IS_NULL(), table1ColInt), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_timestamp IS NULL;
+`)
+	assertStatementSql(t, ScopedSelect(table1, table1ColTimestamp.IS_NULL(), table1ColInt).
+		WHERE(table1ColInt.GT(Int(2)).OR(table1ColBool.IS_TRUE())), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_timestamp IS NULL AND ((table1.col_int > $1) OR table1.col_bool IS TRUE);
+`, int64(2))
+}
+
+type queryRecorderDB struct {
+	queries []string
+}
+
+var errQueryRecorded = errors.New("query recorded")
+
+func (q *queryRecorderDB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return q.ExecContext(context.Background(), query, args...)
+}
+
+func (q *queryRecorderDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	q.queries = append(q.queries, query)
+	return nil, errQueryRecorded
+}
+
+func (q *queryRecorderDB) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return q.QueryContext(context.Background(), query, args...)
+}
+
+func (q *queryRecorderDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	q.queries = append(q.queries, query)
+	return nil, errQueryRecorded
+}
+
+func TestSelectWithContextSchema(t *testing.T) {
+	searchPathCol := IntegerColumn("id")
+	searchPathTable := NewTable("", "search_path_table", "", searchPathCol)
+
+	stmt := SELECT(table1Col1, searchPathCol).
+		FROM(table1.INNER_JOIN(searchPathTable, searchPathCol.EQ(table1Col1)))
+
+	db := &queryRecorderDB{}
+	tenantCtx := WithSchema(context.Background(), "tenant_1")
+
+	var dest []struct{}
+	require.True(t, errors.Is(stmt.QueryContext(tenantCtx, db, &dest), errQueryRecorded))
+	_, err := stmt.ExecContext(tenantCtx, db)
+	require.Equal(t, errQueryRecorded, err)
+	_, err = stmt.Rows(tenantCtx, db)
+	require.Equal(t, errQueryRecorded, err)
+	require.True(t, errors.Is(stmt.QueryContext(context.Background(), db, &dest), errQueryRecorded))
+
+	tenantQuery := `
+SELECT table1.col1 AS "table1.col1",
+     search_path_table.id AS "search_path_table.id"
+FROM tenant_1.table1
+     INNER JOIN search_path_table ON (search_path_table.id = table1.col1);
+`
+	require.Equal(t, []string{tenantQuery, tenantQuery, tenantQuery, `
+SELECT table1.col1 AS "table1.col1",
+     search_path_table.id AS "search_path_table.id"
+FROM db.table1
+     INNER JOIN search_path_table ON (search_path_table.id = table1.col1);
+`}, db.queries)
+
+	// statement itself is not modified by context schema
+	assertStatementSql(t, stmt, `
+SELECT table1.col1 AS "table1.col1",
+     search_path_table.id AS "search_path_table.id"
+FROM db.table1
+     INNER JOIN search_path_table ON (search_path_table.id = table1.col1);
+`)
+}
+
+func TestSelectColumnsOf(t *testing.T) {
+	type Table1 struct {
+		Col1     int
+		ColFloat float64
+	}
+
+	assertStatementSql(t, SELECT(ColumnsOf(table1, &[]Table1{})).FROM(table1), `
+SELECT table1.col1 AS "table1.col1",
+     table1.col_float AS "table1.col_float"
+FROM db.table1;
+`)
+}
+
+func TestSelectColumnsByName(t *testing.T) {
+	columns, err := ColumnsByName(table2, "col_int", "col_float")
+	require.NoError(t, err)
+
+	var sums ProjectionList
+	for _, column := range columns {
+		sums = append(sums, SUMf(FloatExp(column)).AS("sum_"+column.Name()))
+	}
+
+	assertStatementSql(t, SELECT(table2ColStr, sums).FROM(table2).GROUP_BY(table2ColStr), `
+SELECT table2.col_str AS "table2.col_str",
+     SUM(table2.col_int) AS "sum_col_int",
+     SUM(table2.col_float) AS "sum_col_float"
+FROM db.table2
+GROUP BY table2.col_str;
+`)
+}
+
+func TestSelectNilPointerComparison(t *testing.T) {
+	var nilString *string
+	str := "str"
+
+	stmt := SELECT(table2ColStr).
+		FROM(table2).
+		WHERE(OR(
+			table2ColStr.EQ(StringPtr(nilString)),
+			table2ColStr.NOT_EQ(StringPtr(&str)),
+			table2ColInt.NOT_EQ(IntPtr(nil)),
+			StringExp(NULL).EQ(table2ColStr),
+		))
+
+	assertDebugStatementSql(t, stmt, `
+SELECT table2.col_str AS "table2.col_str"
+FROM db.table2
+WHERE (
+          (table2.col_str = NULL)
+              OR (table2.col_str != 'str')
+              OR (table2.col_int != NULL)
+              OR (NULL = table2.col_str)
+      );
+`, nil, "str", nil)
+
+	assertDebugStatementSql(t, stmt.RewriteNullComparisons(), `
+SELECT table2.col_str AS "table2.col_str"
+FROM db.table2
+WHERE (
+          (table2.col_str IS NULL)
+              OR (table2.col_str != 'str')
+              OR (table2.col_int IS NOT NULL)
+              OR (table2.col_str IS NULL)
+      );
+`, "str")
+}
+
+func TestSelectGroupByAll(t *testing.T) {
+	assertStatementSql(t, SELECT(
+		table2ColStr,
+		LOWER(table2ColStr).AS("lower"),
+		COUNT(STAR).AS("count"),
+		SUM(table2ColFloat).AS("sum"),
+	).FROM(table2).GROUP_BY_ALL().ORDER_BY(table2ColStr), `
+SELECT table2.col_str AS "table2.col_str",
+     LOWER(table2.col_str) AS "lower",
+     COUNT(*) AS "count",
+     SUM(table2.col_float) AS "sum"
+FROM db.table2
+GROUP BY table2.col_str, LOWER(table2.col_str)
+ORDER BY table2.col_str;
+`)
+
+	assertStatementSql(t, SELECT(table2ColStr, COUNT(STAR)).FROM(table2).GROUP_BY_ALL().GROUP_BY(table2ColStr), `
+SELECT table2.col_str AS "table2.col_str",
+     COUNT(*)
+FROM db.table2
+GROUP BY table2.col_str;
+`)
+}
+
+func TestSelectAsCount(t *testing.T) {
+	query := SELECT(table1Col1, table1ColFloat).
+		FROM(table1).
+		WHERE(table1ColInt.GT(Int(10))).
+		ORDER_BY(table1Col1.DESC()).
+		LIMIT(20).
+		OFFSET(40).
+		FOR(UPDATE())
+
+	assertStatementSql(t, query.AsCount(), `
+SELECT COUNT(*) AS "count"
+FROM (
+          SELECT table1.col1 AS "table1.col1",
+               table1.col_float AS "table1.col_float"
+          FROM db.table1
+          WHERE table1.col_int > $1
+     ) AS t;
+`, int64(10))
+
+	// original statement is not modified
+	assertStatementSql(t, query, `
+SELECT table1.col1 AS "table1.col1",
+     table1.col_float AS "table1.col_float"
+FROM db.table1
+WHERE table1.col_int > $1
+ORDER BY table1.col1 DESC
+LIMIT $2
+OFFSET $3
+FOR UPDATE;
+`, int64(10), int64(20), int64(40))
+}
+
+func TestSelectOrderByAggregate(t *testing.T) {
+	count := COUNT(STAR)
+	total := SUM(table2ColFloat)
+
+	assertStatementSql(t, SELECT(table2ColStr, count.AS("count"), total.AS("total")).
+		FROM(table2).
+		GROUP_BY(table2ColStr).
+		ORDER_BY(count.DESC(), total.DESC().NULLS_LAST(), MAX(table2ColInt), table2ColStr), `
+SELECT table2.col_str AS "table2.col_str",
+     COUNT(*) AS "count",
+     SUM(table2.col_float) AS "total"
+FROM db.table2
+GROUP BY table2.col_str
+ORDER BY "count" DESC, "total" DESC NULLS LAST, MAX(table2.col_int), table2.col_str;
+`)
+
+	// aggregates only
+	assertStatementSql(t, SELECT(count.AS("count")).FROM(table2).ORDER_BY(count), `
+SELECT COUNT(*) AS "count"
+FROM db.table2
+ORDER BY "count";
+`)
+
+	assertSerializeErr(t, SELECT(table2ColStr, count.AS("count")).FROM(table2).ORDER_BY(count.DESC()),
+		"jet: ORDER BY aggregate requires GROUP BY clause, if SELECT clause has non-aggregate projections")
+
+	// window functions are not aggregates
+	assertStatementSql(t, SELECT(table2ColStr, ROW_NUMBER().OVER().AS("row_num")).FROM(table2).ORDER_BY(ROW_NUMBER().OVER()), `
+SELECT table2.col_str AS "table2.col_str",
+     ROW_NUMBER() OVER () AS "row_num"
+FROM db.table2
+ORDER BY ROW_NUMBER() OVER ();
+`)
+}
+
+func TestSelectNULL(t *testing.T) {
+	assertStatementSql(t, SELECT(
+		NULL.AS("null"),
+		CAST(NULL).AS_INTEGER().AS("null_int"),
+		COALESCE(table1ColInt, NULL).AS("coalesce"),
+	).FROM(table1), `
+SELECT NULL AS "null",
+     NULL::integer AS "null_int",
+     COALESCE(table1.col_int, NULL) AS "coalesce"
+FROM db.table1;
+`)
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// SetConstraintsStatement is interface for PostgreSQL SET CONSTRAINTS statement
+type SetConstraintsStatement interface {
+	Statement
+
+	// DEFERRED sets constraints to be checked at transaction commit
+	DEFERRED() SetConstraintsStatement
+	// IMMEDIATE sets constraints to be checked at the end of each statement
+	IMMEDIATE() SetConstraintsStatement
+}
+
+// SET_CONSTRAINTS creates SET CONSTRAINTS statement for the list of constraint names. If constraint names are not
+// specified, statement is applied to ALL deferrable constraints. Statement affects only the current transaction.
+func SET_CONSTRAINTS(constraintNames ...string) SetConstraintsStatement {
+	newSetConstraints := &setConstraintsStatementImpl{}
+	newSetConstraints.SerializerStatement = jet.NewStatementImpl(Dialect, jet.SetConstraintsStatementType, newSetConstraints,
+		&newSetConstraints.SetConstraints)
+
+	newSetConstraints.SetConstraints.ConstraintNames = constraintNames
+	newSetConstraints.SetConstraints.Mode = "IMMEDIATE"
+
+	return newSetConstraints
+}
+
+type setConstraintsStatementImpl struct {
+	jet.SerializerStatement
+
+	SetConstraints clauseSetConstraints
+}
+
+func (s *setConstraintsStatementImpl) DEFERRED() SetConstraintsStatement {
+	s.SetConstraints.Mode = "DEFERRED"
+	return s
+}
+
+func (s *setConstraintsStatementImpl) IMMEDIATE() SetConstraintsStatement {
+	s.SetConstraints.Mode = "IMMEDIATE"
+	return s
+}
+
+type clauseSetConstraints struct {
+	ConstraintNames []string
+	Mode            string
+}
+
+func (s *clauseSetConstraints) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	out.NewLine()
+	out.WriteString("SET CONSTRAINTS")
+
+	if len(s.ConstraintNames) == 0 {
+		out.WriteString("ALL")
+	}
+
+	for i, constraintName := range s.ConstraintNames {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+		out.WriteIdentifier(constraintName)
+	}
+
+	out.WriteString(s.Mode)
+}
+
+// DeferConstraints sets constraints with constraintNames, or all deferrable constraints if constraintNames are not
+// specified, to be checked at the tx commit. This allows, for instance, insertion of rows with circular foreign keys.
+// Constraints have to be declared as DEFERRABLE.
+func DeferConstraints(ctx context.Context, tx *sql.Tx, constraintNames ...string) error {
+	_, err := SET_CONSTRAINTS(constraintNames...).DEFERRED().ExecContext(ctx, tx)
+	return err
+}
+
+// ImmediateConstraints sets constraints with constraintNames, or all constraints if constraintNames are not specified,
+// to be checked at the end of each tx statement. Deferred constraints pending checks are checked immediately.
+func ImmediateConstraints(ctx context.Context, tx *sql.Tx, constraintNames ...string) error {
+	_, err := SET_CONSTRAINTS(constraintNames...).IMMEDIATE().ExecContext(ctx, tx)
+	return err
+}
@@ -0,0 +1,20 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestSetConstraints(t *testing.T) {
+	assertStatementSql(t, SET_CONSTRAINTS().DEFERRED(), `
+SET CONSTRAINTS ALL DEFERRED;
+`)
+	assertStatementSql(t, SET_CONSTRAINTS().IMMEDIATE(), `
+SET CONSTRAINTS ALL IMMEDIATE;
+`)
+	assertStatementSql(t, SET_CONSTRAINTS("order_customer_fk", "Customer_Order_FK").DEFERRED(), `
+SET CONSTRAINTS order_customer_fk, "Customer_Order_FK" DEFERRED;
+`)
+	assertDebugStatementSql(t, SET_CONSTRAINTS("order_customer_fk"), `
+SET CONSTRAINTS order_customer_fk IMMEDIATE;
+`)
+}
@@ -1,6 +1,10 @@
 package postgres
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"context"
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
 
 // UNION effectively appends the result of sub-queries(select statements) into single query.
 // It eliminates duplicate rows from its result.
@@ -47,6 +51,9 @@ type setStatement interface {
 	OFFSET(offset int64) setStatement
 
 	AsTable(alias string) SelectTable
+
+	// ExplainJSON executes EXPLAIN (FORMAT JSON) of the statement over db connection/transaction and returns parsed execution plan
+	ExplainJSON(ctx context.Context, db qrm.DB) (*QueryPlan, error)
 }
 
 type setOperators interface {
@@ -133,6 +140,10 @@ func (s *setStatementImpl) AsTable(alias string) SelectTable {
 	return newSelectTable(s, alias)
 }
 
+func (s *setStatementImpl) ExplainJSON(ctx context.Context, db qrm.DB) (*QueryPlan, error) {
+	return explainJSON(ctx, db, s)
+}
+
 const (
 	union     = "UNION"
 	intersect = "INTERSECT"
@@ -79,3 +79,25 @@ EXCEPT ALL
 `)
 
 }
+
+func TestSelectSetsColumnCountMismatch(t *testing.T) {
+	select1 := SELECT(table1ColBool, table1ColInt).FROM(table1)
+	select2 := SELECT(table2ColBool).FROM(table2)
+
+	assertStatementSqlErr(t, UNION(select1, select2),
+		"jet: each UNION query must have the same number of columns, first query has 2 columns, but query 2 has 1 columns")
+	assertStatementSqlErr(t, select1.UNION(SELECT(table2ColBool, table2ColInt).FROM(table2)).EXCEPT(select2),
+		"jet: each EXCEPT query must have the same number of columns, first query has 2 columns, but query 2 has 1 columns")
+
+	assertStatementSql(t, UNION_ALL(SELECT(STAR).FROM(table1), select2), `
+(
+     SELECT *
+     FROM db.table1
+)
+UNION ALL
+(
+     SELECT table2.col_bool AS "table2.col_bool"
+     FROM db.table2
+);
+`)
+}
@@ -6,3 +6,9 @@ import "github.com/go-jet/jet/v2/internal/jet"
 func RawStatement(rawQuery string, namedArguments ...RawArgs) Statement {
 	return jet.RawStatement(Dialect, rawQuery, namedArguments...)
 }
+
+// ExpressionSql serializes expression(for instance WHERE condition) alone, independently of the surrounding statement,
+// into parametrized sql fragment and list of arguments. Placeholders are relative to the fragment and numbering starts from $1.
+func ExpressionSql(expression Expression) (query string, args []interface{}, err error) {
+	return jet.ExpressionSql(Dialect, expression)
+}
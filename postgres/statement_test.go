@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestExpressionSql(t *testing.T) {
+	condition := table1ColInt.GT(Int(10)).AND(table1ColFloat.LT(Float(2.5)))
+
+	query, args, err := ExpressionSql(condition)
+	require.NoError(t, err)
+	require.Equal(t, "(table1.col_int > $1) AND (table1.col_float < $2)", query)
+	require.Equal(t, []interface{}{int64(10), 2.5}, args)
+
+	// fragment placeholders do not depend on placeholders of the statement using the same condition
+	stmt := SELECT(table1ColInt).FROM(table1).WHERE(table1Col1.EQ(Int(1)).AND(condition))
+	assertStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE (table1.col1 = $1) AND ((table1.col_int > $2) AND (table1.col_float < $3));
+`, int64(1), int64(10), 2.5)
+
+	query, args, err = ExpressionSql(condition)
+	require.NoError(t, err)
+	require.Equal(t, "(table1.col_int > $1) AND (table1.col_float < $2)", query)
+
+	_, _, err = ExpressionSql(nil)
+	require.EqualError(t, err, "jet: expression is nil")
+
+	_, _, err = ExpressionSql(CASE().ELSE(Int(1)))
+	require.EqualError(t, err, "jet: invalid case Statement. There should be at least one WHEN/THEN pair. ")
+}
+
+func TestStatementWithIdentifierCase(t *testing.T) {
+	userID := IntegerColumn("UserID")
+	userName := StringColumn("user_name")
+	userAccount := NewTable("Accounts", "UserAccount", "", userID, userName)
+
+	stmt := SELECT(userName).FROM(userAccount).WHERE(userID.EQ(Int(1)))
+
+	assertStatementSql(t, stmt, `
+SELECT "UserAccount".user_name AS "UserAccount.user_name"
+FROM "Accounts"."UserAccount"
+WHERE "UserAccount"."UserID" = $1;
+`)
+	assertStatementSql(t, stmt.WithIdentifierCase(IdentifierCasePreserve), `
+SELECT "UserAccount"."user_name" AS "UserAccount.user_name"
+FROM "Accounts"."UserAccount"
+WHERE "UserAccount"."UserID" = $1;
+`)
+	assertStatementSql(t, stmt.WithIdentifierCase(IdentifierCaseLower), `
+SELECT useraccount.user_name AS "UserAccount.user_name"
+FROM accounts.useraccount
+WHERE useraccount.userid = $1;
+`)
+	assertDebugStatementSql(t, stmt.WithIdentifierCase(IdentifierCaseLower), `
+SELECT useraccount.user_name AS "UserAccount.user_name"
+FROM accounts.useraccount
+WHERE useraccount.userid = 1;
+`)
+}
+
+func TestStatementNamedSql(t *testing.T) {
+	query, args, err := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(OR(table1Col1.EQ(Int(1)), table1Col1.EQ(Int(2)), table1Col1.EQ(Int(1))).AND(table1ColBool.EQ(Bool(true)))).
+		NamedSql()
+
+	require.NoError(t, err)
+	require.Equal(t, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE (
+          (table1.col1 = :col1)
+              OR (table1.col1 = :col1_2)
+              OR (table1.col1 = :col1)
+      ) AND (table1.col_bool = CAST(:col_bool AS boolean));
+`, query)
+	require.Equal(t, map[string]interface{}{"col1": int64(1), "col1_2": int64(2), "col_bool": true}, args)
+
+	query, args, err = table2.INSERT(table2ColInt, table2ColStr).
+		VALUES(1, "str").
+		VALUES(2, "str").
+		NamedSql()
+
+	require.NoError(t, err)
+	require.Equal(t, `
+INSERT INTO db.table2 (col_int, col_str)
+VALUES (:col_int, :col_str),
+       (:col_int_2, :col_str);
+`, query)
+	require.Equal(t, map[string]interface{}{"col_int": 1, "col_int_2": 2, "col_str": "str"}, args)
+
+	query, args, err = RawStatement("SELECT * FROM table1 WHERE col1 = #id OR col_int = #id", RawArgs{"#id": 3}).NamedSql()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM table1 WHERE col1 = :id OR col_int = :id;\n", query)
+	require.Equal(t, map[string]interface{}{"id": 3}, args)
+
+	query, args, err = SELECT(table1ColInt, Int(5).AS("five")).
+		FROM(table1).
+		WHERE(table1ColInt.IS_NOT_NULL().AND(Int(1).EQ(Int(2)))).
+		LIMIT(10).
+		NamedSql()
+	require.NoError(t, err)
+	require.Equal(t, `
+SELECT table1.col_int AS "table1.col_int",
+     :param_1 AS "five"
+FROM db.table1
+WHERE table1.col_int IS NOT NULL AND (:param_2 = :param_3)
+LIMIT :param_4;
+`, query)
+	require.Equal(t, map[string]interface{}{"param_1": int64(5), "param_2": int64(1), "param_3": int64(2), "param_4": int64(10)}, args)
+
+	_, _, err = table2.UPDATE(table2ColInt).SET(1).NamedSql()
+	require.True(t, errors.Is(err, ErrMissingWhere))
+}
+
+func TestStatementDeduplicateParameters(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt).AND(table2Col3.EQ(Int(7))))).
+		WHERE(table1Col1.EQ(Int(7)).AND(table1ColFloat.GT(Float(7))).AND(table2ColStr.IN(String("a"), String("b"), String("a"))))
+
+	assertStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+     INNER JOIN db.table2 ON ((table1.col_int = table2.col_int) AND (table2.col3 = $1))
+WHERE ((table1.col1 = $2) AND (table1.col_float > $3)) AND (table2.col_str IN ($4, $5, $6));
+`, int64(7), int64(7), float64(7), "a", "b", "a")
+
+	assertStatementSql(t, stmt.DeduplicateParameters(), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+     INNER JOIN db.table2 ON ((table1.col_int = table2.col_int) AND (table2.col3 = $1))
+WHERE ((table1.col1 = $1) AND (table1.col_float > $2)) AND (table2.col_str IN ($3, $4, $3));
+`, int64(7), float64(7), "a", "b")
+}
@@ -7,6 +7,25 @@ type Table interface {
 	readableTable
 	writableTable
 	jet.SerializerTable
+
+	// Only returns readable table which excludes rows from descendant tables(inheriting tables and partitions),
+	// serialized as: ONLY table
+	Only() ReadableTable
+	// IncludeDescendants returns readable table which explicitly includes rows from descendant tables(inheriting
+	// tables and partitions), serialized as: table *
+	IncludeDescendants() ReadableTable
+
+	// Projection defines named projection set of table columns, if columns are passed, or returns previously defined
+	// projection set otherwise. Panics if projection set is not defined.
+	Projection(name string, columns ...jet.Column) ColumnList
+
+	// Column returns table column with the name, as it was declared in the table. If table does not have the column,
+	// statement using returned column panics when serialized.
+	Column(name string) Column
+	// ColumnOrError returns table column with the name, or an error if table does not have the column.
+	ColumnOrError(name string) (Column, error)
+	// Projections returns list of all the table columns, in the order they are declared in the table
+	Projections() ProjectionList
 }
 
 type readableTable interface {
@@ -27,6 +46,13 @@ type readableTable interface {
 
 	// Creates a cross join tableName Expression using onCondition.
 	CROSS_JOIN(table ReadableTable) ReadableTable
+
+	// Creates a natural join tableName Expression, which joins tables on all the columns with the same name.
+	NATURAL_JOIN(table ReadableTable) ReadableTable
+
+	// Creates a join tableName Expression on equality of listed columns, which have the same name in both tables:
+	// JOIN table USING (column1, column2). Panics if columns list is empty.
+	JOIN_USING(table ReadableTable, columns ...jet.Column) ReadableTable
 }
 
 type writableTable interface {
@@ -81,12 +107,20 @@ func (r readableTableInterfaceImpl) CROSS_JOIN(table ReadableTable) ReadableTabl
 	return newJoinTable(r.parent, table, jet.CrossJoin, nil)
 }
 
+func (r readableTableInterfaceImpl) NATURAL_JOIN(table ReadableTable) ReadableTable {
+	return newJoinTable(r.parent, table, jet.NaturalJoin, nil)
+}
+
+func (r readableTableInterfaceImpl) JOIN_USING(table ReadableTable, columns ...jet.Column) ReadableTable {
+	return wrapJoinTable(jet.NewJoinTableUsing(r.parent, table, jet.UnwidColumnList(columns)))
+}
+
 type writableTableInterfaceImpl struct {
 	parent WritableTable
 }
 
 func (w *writableTableInterfaceImpl) INSERT(columns ...jet.Column) InsertStatement {
-	return newInsertStatement(w.parent, jet.UnwidColumnList(columns))
+	return newInsertStatement(w.parent, columns)
 }
 
 func (w *writableTableInterfaceImpl) UPDATE(columns ...jet.Column) UpdateStatement {
@@ -106,13 +140,21 @@ type tableImpl struct {
 	writableTableInterfaceImpl
 
 	jet.SerializerTable
+
+	projectionSets *jet.ProjectionSets
 }
 
 // NewTable creates new table with schema Name, table Name and list of columns
 func NewTable(schemaName, name, alias string, columns ...jet.ColumnExpression) Table {
+	t := newTable(jet.NewTable(schemaName, name, alias, columns...))
+	t.projectionSets = &jet.ProjectionSets{}
 
+	return t
+}
+
+func newTable(serializerTable jet.SerializerTable) *tableImpl {
 	t := &tableImpl{
-		SerializerTable: jet.NewTable(schemaName, name, alias, columns...),
+		SerializerTable: serializerTable,
 	}
 
 	t.readableTableInterfaceImpl.parent = t
@@ -121,14 +163,44 @@ func NewTable(schemaName, name, alias string, columns ...jet.ColumnExpression) T
 	return t
 }
 
+func (t *tableImpl) Only() ReadableTable {
+	return newTable(jet.OnlyTable(t.SerializerTable))
+}
+
+func (t *tableImpl) IncludeDescendants() ReadableTable {
+	return newTable(jet.TableWithDescendants(t.SerializerTable))
+}
+
+// Projection defines named projection set of table columns, if columns are passed, or returns previously defined
+// projection set otherwise.
+func (t *tableImpl) Projection(name string, columns ...jet.Column) ColumnList {
+	return t.projectionSets.Projection(t, name, columns...)
+}
+
+func (t *tableImpl) Column(name string) Column {
+	return jet.TableColumn(t, name)
+}
+
+func (t *tableImpl) ColumnOrError(name string) (Column, error) {
+	return jet.LookupColumn(t, name)
+}
+
+func (t *tableImpl) Projections() ProjectionList {
+	return jet.TableProjections(t)
+}
+
 type joinTable struct {
 	readableTableInterfaceImpl
 	jet.JoinTable
 }
 
 func newJoinTable(lhs jet.Serializer, rhs jet.Serializer, joinType jet.JoinType, onCondition BoolExpression) ReadableTable {
+	return wrapJoinTable(jet.NewJoinTable(lhs, rhs, joinType, onCondition))
+}
+
+func wrapJoinTable(table jet.JoinTable) ReadableTable {
 	newJoinTable := &joinTable{
-		JoinTable: jet.NewJoinTable(lhs, rhs, joinType, onCondition),
+		JoinTable: table,
 	}
 
 	newJoinTable.readableTableInterfaceImpl.parent = newJoinTable
@@ -0,0 +1,50 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// TableStatement is interface for PostgreSQL TABLE statement, shorthand for SELECT * FROM table
+type TableStatement interface {
+	Statement
+
+	ORDER_BY(orderByClauses ...OrderByClause) TableStatement
+	LIMIT(limit int64) TableStatement
+	OFFSET(offset int64) TableStatement
+}
+
+// TABLE creates new TABLE statement, which returns all the rows and columns of the table
+func TABLE(table jet.SerializerTable) TableStatement {
+	newTable := &tableStatementImpl{}
+	newTable.SerializerStatement = jet.NewStatementImpl(Dialect, jet.SelectStatementType, newTable,
+		&newTable.StatementBegin, &newTable.OrderBy, &newTable.Limit, &newTable.Offset)
+
+	newTable.StatementBegin.Name = "TABLE"
+	newTable.StatementBegin.Tables = []jet.SerializerTable{table}
+	newTable.Limit.Count = -1
+	newTable.Offset.Count = -1
+
+	return newTable
+}
+
+type tableStatementImpl struct {
+	jet.SerializerStatement
+
+	StatementBegin jet.ClauseStatementBegin
+	OrderBy        jet.ClauseOrderBy
+	Limit          jet.ClauseLimit
+	Offset         jet.ClauseOffset
+}
+
+func (t *tableStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) TableStatement {
+	t.OrderBy.List = orderByClauses
+	return t
+}
+
+func (t *tableStatementImpl) LIMIT(limit int64) TableStatement {
+	t.Limit.Count = limit
+	return t
+}
+
+func (t *tableStatementImpl) OFFSET(offset int64) TableStatement {
+	t.Offset.Count = offset
+	return t
+}
@@ -0,0 +1,17 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestTableStatement(t *testing.T) {
+	assertStatementSql(t, TABLE(table1), `
+TABLE db.table1;
+`)
+	assertStatementSql(t, TABLE(table2).ORDER_BY(table2ColInt.DESC()).LIMIT(10).OFFSET(20), `
+TABLE db.table2
+ORDER BY table2.col_int DESC
+LIMIT $1
+OFFSET $2;
+`, int64(10), int64(20))
+}
@@ -1,9 +1,139 @@
 package postgres
 
 import (
+	"github.com/stretchr/testify/require"
 	"testing"
 )
 
+func TestTableQualifiedName(t *testing.T) {
+	require.Equal(t, "\"db\".\"table1\"", table1.QualifiedName(Dialect))
+	require.Equal(t, "\"user\"", NewTable("", "user", "").QualifiedName(Dialect))
+	require.Equal(t, "\"order\".\"Group\"", NewTable("order", "Group", "g").QualifiedName(Dialect))
+}
+
+func TestTableOnlyAndIncludeDescendants(t *testing.T) {
+	assertSerialize(t, table1.Only(), `ONLY db.table1`)
+	assertSerialize(t, table1.IncludeDescendants(), `db.table1 *`)
+
+	assertStatementSql(t, table1.Only().
+		INNER_JOIN(table2.IncludeDescendants(), table1ColInt.EQ(table2ColInt)).
+		SELECT(table1ColInt), `
+SELECT table1.col_int AS "table1.col_int"
+FROM ONLY db.table1
+     INNER JOIN db.table2 * ON (table1.col_int = table2.col_int);
+`)
+}
+
+// employeeTable mimics generated table type, whose AS method constructs new table with its own columns
+type employeeTable struct {
+	Table
+
+	ID        ColumnInteger
+	ManagerID ColumnInteger
+}
+
+func newEmployeeTable(alias string) employeeTable {
+	id := IntegerColumn("id")
+	managerID := IntegerColumn("manager_id")
+
+	return employeeTable{
+		Table:     NewTable("db", "employee", alias, id, managerID),
+		ID:        id,
+		ManagerID: managerID,
+	}
+}
+
+func (e employeeTable) AS(alias string) employeeTable {
+	return newEmployeeTable(alias)
+}
+
+func TestTableAliasDoesNotModifyOriginalTable(t *testing.T) {
+	employee := newEmployeeTable("")
+	manager := employee.AS("manager")
+
+	selectEmployees := SELECT(employee.ID).FROM(employee).WHERE(employee.ManagerID.EQ(Int(1)))
+
+	selfJoin := SELECT(employee.ID, manager.ID).
+		FROM(employee.INNER_JOIN(manager, employee.ManagerID.EQ(manager.ID)))
+
+	subordinate := employee.AS("subordinate")
+
+	assertDebugStatementSql(t, selectEmployees, `
+SELECT employee.id AS "employee.id"
+FROM db.employee
+WHERE employee.manager_id = 1;
+`)
+	assertStatementSql(t, selfJoin, `
+SELECT employee.id AS "employee.id",
+     manager.id AS "manager.id"
+FROM db.employee
+     INNER JOIN db.employee AS manager ON (employee.manager_id = manager.id);
+`)
+	assertStatementSql(t, SELECT(subordinate.ID).FROM(subordinate), `
+SELECT subordinate.id AS "subordinate.id"
+FROM db.employee AS subordinate;
+`)
+	require.Equal(t, "", employee.Alias())
+	require.Equal(t, "manager", manager.Alias())
+}
+
+func TestTableColumn(t *testing.T) {
+	require.Equal(t, table1ColInt, table1.Column("col_int"))
+	require.Equal(t, table1ColBool, table1.Column("col_bool"))
+
+	column, err := table1.ColumnOrError("col_float")
+	require.NoError(t, err)
+	_, isFloatColumn := column.(ColumnFloat)
+	require.True(t, isFloatColumn)
+
+	_, err = table1.ColumnOrError("col_missing")
+	require.EqualError(t, err, "jet: column 'col_missing' not found in table 'table1'")
+
+	assertStatementSql(t, SELECT(table1.Column("col_int")).FROM(table1), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1;
+`)
+	assertStatementSqlErr(t, SELECT(table1.Column("col_missing")).FROM(table1),
+		"jet: column 'col_missing' not found in table 'table1'")
+}
+
+func TestTableProjections(t *testing.T) {
+	users := NewTable("db", "users", "", IntegerColumn("id"), StringColumn("name"), BoolColumn("active"))
+
+	require.Len(t, users.Projections(), 3)
+
+	assertStatementSql(t, SELECT(users.Projections()).FROM(users), `
+SELECT users.id AS "users.id",
+     users.name AS "users.name",
+     users.active AS "users.active"
+FROM db.users;
+`)
+}
+
+func TestTableProjection(t *testing.T) {
+	idColumn := IntegerColumn("id")
+	nameColumn := StringColumn("name")
+	bioColumn := StringColumn("bio")
+	users := NewTable("db", "users", "", idColumn, nameColumn, bioColumn)
+
+	summary := users.Projection("summary", idColumn, nameColumn)
+	require.Equal(t, ColumnList{idColumn, nameColumn}, summary)
+	require.Equal(t, summary, users.Projection("summary"))
+	require.Equal(t, ColumnList{idColumn, nameColumn, bioColumn},
+		users.Projection("detail", users.Projection("summary"), bioColumn))
+
+	assertStatementSql(t, users.SELECT(users.Projection("summary")).WHERE(idColumn.EQ(Int(1))), `
+SELECT users.id AS "users.id",
+     users.name AS "users.name"
+FROM db.users
+WHERE users.id = $1;
+`)
+
+	assertPanicErr(t, func() { users.Projection("list") }, "jet: projection set 'list' is not defined for table 'users'")
+	assertPanicErr(t, func() { users.Projection("invalid", idColumn, table2ColInt) },
+		"jet: column 'col_int' of projection set 'invalid' is not a column of table 'users'")
+}
+
 func TestJoinNilInputs(t *testing.T) {
 	assertSerializeErr(t, table2.INNER_JOIN(nil, table1ColBool.EQ(table2ColBool)),
 		"jet: right hand side of join operation is nil table")
@@ -100,6 +230,36 @@ CROSS JOIN db.table2
 CROSS JOIN db.table3`)
 }
 
+func TestNATURAL_JOIN(t *testing.T) {
+	assertSerialize(t, table1.
+		NATURAL_JOIN(table2),
+		`db.table1
+NATURAL JOIN db.table2`)
+	assertSerialize(t, table1.
+		NATURAL_JOIN(table2).
+		INNER_JOIN(table3, table1ColInt.EQ(table3ColInt)),
+		`db.table1
+NATURAL JOIN db.table2
+INNER JOIN db.table3 ON (table1.col_int = table3.col_int)`)
+}
+
+func TestJOIN_USING(t *testing.T) {
+	assertSerialize(t, table1.
+		JOIN_USING(table2, table1ColInt),
+		`db.table1
+JOIN db.table2 USING (col_int)`)
+	assertSerialize(t, table1.
+		JOIN_USING(table2, table1ColInt, table1ColFloat).
+		JOIN_USING(table3, table3Col1),
+		`db.table1
+JOIN db.table2 USING (col_int, col_float)
+JOIN db.table3 USING (col1)`)
+
+	assertPanicErr(t, func() {
+		table1.JOIN_USING(table2)
+	}, "jet: join USING requires at least one column")
+}
+
 func TestImplicitCROSS_JOIN(t *testing.T) {
 	assertDebugStatementSql(t,
 		SELECT(table1Col1, table2Col3).
@@ -30,5 +30,37 @@ var SetLogger = jet.SetLoggerFunc
 // SetQueryLogger sets automatic query logging function.
 var SetQueryLogger = jet.SetQueryLogger
 
+// WithSchema returns a copy of ctx carrying schema name override. Statements executed with such context
+// are serialized with schema replacing static schema name of every table.
+var WithSchema = jet.WithSchema
+
+// ErrTooManyParameters is returned, before statement execution, when statement has more parameters than
+// PostgreSQL allows in a single statement(at most 65535 parameters).
+var ErrTooManyParameters = jet.ErrTooManyParameters
+
+// ErrMissingWhere is returned, before statement execution, when UPDATE or DELETE statement has no WHERE clause and
+// is not explicitly marked with AllRows.
+var ErrMissingWhere = jet.ErrMissingWhere
+
+// ResultCache is a wrapper around database connection/transaction, which caches mapped query results of statements
+// for a ttl duration. Results are cached by statement dialect, sql query, argument values and types, and destination type.
+type ResultCache = jet.ResultCache
+
+// NewResultCache creates new ResultCache around db, whose cached results expire after ttl duration
+var NewResultCache = jet.NewResultCache
+
 // QueryInfo contains information about executed query
 type QueryInfo = jet.QueryInfo
+
+// IdentifierCase defines how schema, table and column identifiers are written to SQL query.
+// Set with Statement WithIdentifierCase method.
+type IdentifierCase = jet.IdentifierCase
+
+const (
+	// IdentifierCaseDefault quotes identifiers only if they are reserved words or contain upper case or non-ASCII characters
+	IdentifierCaseDefault = jet.IdentifierCaseDefault
+	// IdentifierCasePreserve always quotes identifiers, preserving their case
+	IdentifierCasePreserve = jet.IdentifierCasePreserve
+	// IdentifierCaseLower folds identifiers to lower case
+	IdentifierCaseLower = jet.IdentifierCaseLower
+)
@@ -1,7 +1,9 @@
 package postgres
 
 import (
+	"context"
 	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
 )
 
 // UpdateStatement is interface of SQL UPDATE statement
@@ -13,7 +15,13 @@ type UpdateStatement interface {
 
 	FROM(tables ...ReadableTable) UpdateStatement
 	WHERE(expression BoolExpression) UpdateStatement
+	// AllRows explicitly allows statement without WHERE clause, which updates all table rows. Statement without
+	// WHERE clause, that is not marked with AllRows, can not be serialized or executed.
+	AllRows() UpdateStatement
 	RETURNING(projections ...Projection) UpdateStatement
+
+	// ExplainJSON executes EXPLAIN (FORMAT JSON) of the statement over db connection/transaction and returns parsed execution plan
+	ExplainJSON(ctx context.Context, db qrm.DB) (*QueryPlan, error)
 }
 
 type updateStatementImpl struct {
@@ -74,11 +82,20 @@ func (u *updateStatementImpl) WHERE(expression BoolExpression) UpdateStatement {
 	return u
 }
 
+func (u *updateStatementImpl) AllRows() UpdateStatement {
+	u.Where.Mandatory = false
+	return u
+}
+
 func (u *updateStatementImpl) RETURNING(projections ...jet.Projection) UpdateStatement {
 	u.Returning.ProjectionList = projections
 	return u
 }
 
+func (u *updateStatementImpl) ExplainJSON(ctx context.Context, db qrm.DB) (*QueryPlan, error) {
+	return explainJSON(ctx, db, u)
+}
+
 type clauseSet struct {
 	Columns []jet.Column
 	Values  []jet.Serializer
@@ -95,6 +112,8 @@ func (s *clauseSet) Serialize(statementType jet.StatementType, out *jet.SQLBuild
 		panic("jet: no columns selected")
 	}
 
+	jet.MustBeAssignable(s.Columns...)
+
 	if len(s.Columns) > 1 {
 		out.WriteString("(")
 	}
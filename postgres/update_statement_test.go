@@ -1,8 +1,12 @@
 package postgres
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestUpdateWithOneValue(t *testing.T) {
@@ -60,3 +64,61 @@ func TestInvalidInputs(t *testing.T) {
 	assertStatementSqlErr(t, table1.UPDATE(table1ColInt).SET(1), "jet: WHERE clause not set")
 	assertStatementSqlErr(t, table1.UPDATE(nil).SET(1), "jet: nil column in columns list")
 }
+
+func TestUpdateAllRows(t *testing.T) {
+	assertStatementSql(t, table1.UPDATE(table1ColInt).SET(1).AllRows(), `
+UPDATE db.table1
+SET col_int = $1;
+`, 1)
+
+	_, err := table1.UPDATE(table1ColInt).SET(1).Exec(&queryRecorderDB{})
+	require.True(t, errors.Is(err, ErrMissingWhere))
+
+	var dest []struct{}
+	err = NewResultCache(&queryRecorderDB{}, time.Minute).
+		Query(context.Background(), table1.UPDATE(table1ColInt).SET(1).RETURNING(table1ColInt), &dest)
+	require.True(t, errors.Is(err, ErrMissingWhere))
+}
+
+func TestUpdateGeneratedColumn(t *testing.T) {
+	idCol := IntegerColumn("id")
+	generatedCol := IntegerColumn("col_generated")
+	SetGenerated(generatedCol)
+	table := NewTable("db", "table", "", idCol, generatedCol)
+
+	assertStatementSqlErr(t, table.UPDATE(generatedCol).SET(1).WHERE(idCol.EQ(Int(1))),
+		"jet: can't assign value to generated column 'col_generated'")
+	assertStatementSqlErr(t, table.UPDATE().SET(generatedCol.SET(Int(1))).WHERE(idCol.EQ(Int(1))),
+		"jet: can't assign value to generated column 'col_generated'")
+	assertStatementSqlErr(t, table.UPDATE().SET(ColumnList{idCol, generatedCol}.SET(ROW(Int(1), Int(2)))).WHERE(idCol.EQ(Int(1))),
+		"jet: can't assign value to generated column 'col_generated'")
+}
+
+func TestUpdateModelChangedFieldsOnly(t *testing.T) {
+	type Table1Model struct {
+		ColInt   int
+		ColFloat float64
+		Enabled  bool `alias:"table1.col_bool"`
+		Note     string
+	}
+
+	columns := ColumnList{table1ColInt, table1ColFloat, table1ColBool}
+	model := Table1Model{ColInt: 0, ColFloat: 1.1, Enabled: false}
+
+	stmt := table1.UPDATE(columns.ByFieldNames(model, "Enabled", "ColInt")).
+		MODEL(model).
+		WHERE(table1Col1.EQ(Int(2)))
+
+	assertStatementSql(t, stmt, `
+UPDATE db.table1
+SET (col_int, col_bool) = ($1, $2)
+WHERE table1.col1 = $3;
+`, 0, false, int64(2))
+
+	assertPanicErr(t, func() {
+		columns.ByFieldNames(model, "ColInt", "Note")
+	}, "jet: column for field 'Note' not found")
+	assertPanicErr(t, func() {
+		columns.ByFieldNames(&model, "ColString")
+	}, "jet: model has no field 'ColString'")
+}
@@ -0,0 +1,72 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// ValuesStatement is interface for standalone VALUES statement, which returns constant list of rows.
+// Columns of returned rows are named column1, column2, etc.
+type ValuesStatement interface {
+	Statement
+	jet.HasProjections
+
+	// VALUES appends new row of values to the statement
+	VALUES(value interface{}, values ...interface{}) ValuesStatement
+
+	ORDER_BY(orderByClauses ...OrderByClause) ValuesStatement
+	LIMIT(limit int64) ValuesStatement
+	OFFSET(offset int64) ValuesStatement
+
+	// AS creates derived table from VALUES statement with alias. Optional column names are used instead of
+	// default column1, column2, etc. names, for instance: (VALUES (1, 'a')) AS alias(id, name)
+	AS(alias string, columnNames ...string) SelectTable
+}
+
+// VALUES creates new standalone VALUES statement, with the first row of values
+func VALUES(value interface{}, values ...interface{}) ValuesStatement {
+	newValues := &valuesStatementImpl{}
+	newValues.SerializerStatement = jet.NewStatementImpl(Dialect, jet.ValuesStatementType, newValues,
+		&newValues.Values, &newValues.OrderBy, &newValues.Limit, &newValues.Offset)
+
+	newValues.Limit.Count = -1
+	newValues.Offset.Count = -1
+
+	return newValues.VALUES(value, values...)
+}
+
+type valuesStatementImpl struct {
+	jet.SerializerStatement
+
+	Values  jet.ClauseValues
+	OrderBy jet.ClauseOrderBy
+	Limit   jet.ClauseLimit
+	Offset  jet.ClauseOffset
+}
+
+func (v *valuesStatementImpl) VALUES(value interface{}, values ...interface{}) ValuesStatement {
+	v.Values.Rows = append(v.Values.Rows, jet.UnwindRowFromValues(value, values))
+	return v
+}
+
+func (v *valuesStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) ValuesStatement {
+	v.OrderBy.List = orderByClauses
+	return v
+}
+
+func (v *valuesStatementImpl) LIMIT(limit int64) ValuesStatement {
+	v.Limit.Count = limit
+	return v
+}
+
+func (v *valuesStatementImpl) OFFSET(offset int64) ValuesStatement {
+	v.Offset.Count = offset
+	return v
+}
+
+func (v *valuesStatementImpl) AS(alias string, columnNames ...string) SelectTable {
+	subQuery := &selectTableImpl{
+		SelectTable: jet.NewSelectTable(v, alias, columnNames...),
+	}
+
+	subQuery.readableTableInterfaceImpl.parent = subQuery
+
+	return subQuery
+}
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestValuesStatement(t *testing.T) {
+	assertStatementSql(t, VALUES(1, "a"), `
+VALUES ($1, $2);
+`, 1, "a")
+
+	assertStatementSql(t, VALUES(Int(1), String("a")).
+		VALUES(2, NULL).
+		ORDER_BY(IntegerColumn("column1").DESC()).
+		LIMIT(10).
+		OFFSET(2), `
+VALUES ($1, $2),
+       ($3, NULL)
+ORDER BY column1 DESC
+LIMIT $4
+OFFSET $5;
+`, int64(1), "a", 2, int64(10), int64(2))
+}
+
+func TestValuesStatementAsTable(t *testing.T) {
+	values := VALUES(1, "one").VALUES(2, "two").AS("numbers", "id", "name")
+
+	id := IntegerColumn("id").From(values)
+	name := StringColumn("name").From(values)
+
+	assertStatementSql(t, SELECT(id, name).
+		FROM(values.INNER_JOIN(table1, table1Col1.EQ(id))), `
+SELECT numbers.id AS "id",
+     numbers.name AS "name"
+FROM (
+          VALUES ($1, $2),
+                 ($3, $4)
+     ) AS numbers(id, name)
+     INNER JOIN db.table1 ON (table1.col1 = numbers.id);
+`, 1, "one", 2, "two")
+
+	assertSerialize(t, VALUES(1).AS("v"), `(
+     VALUES ($1)
+) AS v`, 1)
+}
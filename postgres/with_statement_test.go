@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestWITHReusedSubQuery(t *testing.T) {
+	filtered := CTE("filtered")
+	filteredColInt := table2ColInt.From(filtered)
+
+	stmt := WITH(
+		filtered.AS(
+			SELECT(table2ColInt).
+				FROM(table2).
+				WHERE(table2ColStr.EQ(String("foo"))),
+		),
+	)(
+		SELECT(
+			table1ColInt,
+			SELECT(MAXi(filteredColInt)).FROM(filtered).AS("max_int"),
+		).FROM(
+			table1,
+		).WHERE(
+			table1ColInt.IN(SELECT(filteredColInt).FROM(filtered)),
+		),
+	)
+
+	assertStatementSql(t, stmt, `
+WITH filtered AS (
+     SELECT table2.col_int AS "table2.col_int"
+     FROM db.table2
+     WHERE table2.col_str = $1
+)
+SELECT table1.col_int AS "table1.col_int",
+     (
+          SELECT MAX(filtered."table2.col_int")
+          FROM filtered
+     ) AS "max_int"
+FROM db.table1
+WHERE table1.col_int IN (
+           SELECT filtered."table2.col_int" AS "table2.col_int"
+           FROM filtered
+      );
+`, "foo")
+}
+
+func TestWITHExplicitColumnNames(t *testing.T) {
+	colA := IntegerColumn("a")
+	colB := StringColumn("b")
+	cte := CTE("cte", colA, colB)
+
+	stmt := WITH(
+		cte.AS(
+			SELECT(table2ColInt, table2ColStr).FROM(table2),
+		),
+	)(
+		SELECT(cte.AllColumns(), table1ColFloat).
+			FROM(cte.INNER_JOIN(table1, colA.EQ(table1ColInt))).
+			WHERE(colB.EQ(String("foo"))),
+	)
+
+	assertStatementSql(t, stmt, `
+WITH cte (a, b) AS (
+     SELECT table2.col_int AS "table2.col_int",
+          table2.col_str AS "table2.col_str"
+     FROM db.table2
+)
+SELECT cte.a AS "a",
+     cte.b AS "b",
+     table1.col_float AS "table1.col_float"
+FROM cte
+     INNER JOIN db.table1 ON (cte.a = table1.col_int)
+WHERE cte.b = $1;
+`, "foo")
+}
+
+func TestWITHChainedCTEs(t *testing.T) {
+	filtered := CTE("filtered")
+	filteredColInt := table2ColInt.From(filtered)
+	summed := CTE("summed")
+	summedTotal := IntegerColumn("total").From(summed)
+
+	defineFiltered := filtered.AS(
+		SELECT(table2ColInt).
+			FROM(table2).
+			WHERE(table2ColStr.EQ(String("foo"))),
+	)
+	defineSummed := summed.AS(
+		SELECT(SUM(filteredColInt).AS("total")).
+			FROM(filtered),
+	)
+
+	expectedSQL := `
+WITH filtered AS (
+     SELECT table2.col_int AS "table2.col_int"
+     FROM db.table2
+     WHERE table2.col_str = $1
+),summed AS (
+     SELECT SUM(filtered."table2.col_int") AS "total"
+     FROM filtered
+)
+SELECT summed.total AS "total"
+FROM summed;
+`
+	assertStatementSql(t, WITH(defineFiltered, defineSummed)(SELECT(summedTotal).FROM(summed)), expectedSQL, "foo")
+	// summed references filtered, so filtered is defined first regardless of the order ctes are listed
+	assertStatementSql(t, WITH(defineSummed, defineFiltered)(SELECT(summedTotal).FROM(summed)), expectedSQL, "foo")
+}
+
+func TestWITHMaterializedHints(t *testing.T) {
+	cte := CTE("cte")
+	query := SELECT(table2ColInt).FROM(table2)
+	mainQuery := SELECT(table2ColInt.From(cte)).FROM(cte)
+
+	assertStatementSql(t, WITH(cte.AS(query).Materialized())(mainQuery), `
+WITH cte AS MATERIALIZED (
+     SELECT table2.col_int AS "table2.col_int"
+     FROM db.table2
+)
+SELECT cte."table2.col_int" AS "table2.col_int"
+FROM cte;
+`)
+	assertStatementSql(t, WITH(cte.AS(query).NotMaterialized())(mainQuery), `
+WITH cte AS NOT MATERIALIZED (
+     SELECT table2.col_int AS "table2.col_int"
+     FROM db.table2
+)
+SELECT cte."table2.col_int" AS "table2.col_int"
+FROM cte;
+`)
+	assertStatementSql(t, WITH(cte.AS_MATERIALIZED(query))(mainQuery), `
+WITH cte AS MATERIALIZED (
+     SELECT table2.col_int AS "table2.col_int"
+     FROM db.table2
+)
+SELECT cte."table2.col_int" AS "table2.col_int"
+FROM cte;
+`)
+}
+
+func TestWITHSelfReferencingCTE(t *testing.T) {
+	n := IntegerColumn("n")
+	counter := CTE("counter", n)
+
+	stmt := WITH(
+		counter.AS(
+			SELECT(Int(1)).UNION_ALL(
+				SELECT(n.ADD(Int(1))).FROM(counter).WHERE(n.LT(Int(10))),
+			),
+		),
+	)(
+		SELECT(n).FROM(table1.INNER_JOIN(counter, n.EQ(table1ColInt))),
+	)
+
+	assertDebugStatementSql(t, stmt, `
+WITH RECURSIVE counter (n) AS (
+     (
+          SELECT 1
+     )
+     UNION ALL
+     (
+          SELECT counter.n + 1
+          FROM counter
+          WHERE counter.n < 10
+     )
+)
+SELECT counter.n AS "n"
+FROM db.table1
+     INNER JOIN counter ON (counter.n = table1.col_int);
+`)
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
+	"github.com/go-jet/jet/v2/internal/utils"
 	"github.com/go-jet/jet/v2/internal/utils/min"
 	"reflect"
 	"strconv"
@@ -45,6 +46,48 @@ func (nb *NullBool) Scan(value interface{}) error {
 	return nil
 }
 
+// NullDuration struct
+type NullDuration struct {
+	Duration time.Duration
+	Valid    bool
+}
+
+// Scan implements the Scanner interface. Integers are scanned as nanoseconds, and text values are parsed as
+// PostgreSQL intervals. Intervals with months or days can not be converted to duration without loss.
+func (nd *NullDuration) Scan(value interface{}) error {
+	var text string
+
+	switch v := value.(type) {
+	case string:
+		text = v
+	case []byte:
+		text = string(v)
+	default:
+		var nanoseconds sql.NullInt64
+
+		if err := nanoseconds.Scan(value); err != nil {
+			return err
+		}
+
+		nd.Duration, nd.Valid = time.Duration(nanoseconds.Int64), nanoseconds.Valid
+		return nil
+	}
+
+	months, days, microseconds, err := utils.ParseInterval(text)
+
+	if err != nil {
+		return err
+	}
+
+	if months != 0 || days != 0 {
+		return fmt.Errorf("can't scan time.Duration from interval %q with months or days", text)
+	}
+
+	nd.Duration, nd.Valid = time.Duration(microseconds)*time.Microsecond, true
+
+	return nil
+}
+
 // NullTime struct
 type NullTime struct {
 	sql.NullTime
@@ -262,7 +262,14 @@ func mapRowToStruct(
 
 			updated = true
 
-			if fieldMap.implementsScanner {
+			if fieldMap.jsonType {
+				err := unmarshalJSON(scannedValue, fieldValue)
+
+				if err != nil {
+					return updated, fmt.Errorf(`can't unmarshal %T(%q) from column '%s' to '%s %s': %w`, scannedValue.Interface(), scannedValue.Interface(),
+						scanContext.rowElemAlias(fieldMap.rowIndex), field.Name, field.Type.String(), err)
+				}
+			} else if fieldMap.implementsScanner {
 				initializeValueIfNilPtr(fieldValue)
 				fieldScanner := getScanner(fieldValue)
 
@@ -271,14 +278,15 @@ func mapRowToStruct(
 				err := fieldScanner.Scan(value)
 
 				if err != nil {
-					return updated, fmt.Errorf(`can't scan %T(%q) to '%s %s': %w`, value, value, field.Name, field.Type.String(), err)
+					return updated, fmt.Errorf(`can't scan %T(%q) from column '%s' to '%s %s': %w`, value, value,
+						scanContext.rowElemAlias(fieldMap.rowIndex), field.Name, field.Type.String(), err)
 				}
 			} else {
 				err := assign(scannedValue, fieldValue)
 
 				if err != nil {
-					return updated, fmt.Errorf(`can't assign %T(%q) to '%s %s': %w`, scannedValue.Interface(), scannedValue.Interface(),
-						field.Name, field.Type.String(), err)
+					return updated, fmt.Errorf(`can't assign %T(%q) from column '%s' to '%s %s': %w`, scannedValue.Interface(), scannedValue.Interface(),
+						scanContext.rowElemAlias(fieldMap.rowIndex), field.Name, field.Type.String(), err)
 				}
 			}
 		}
@@ -287,6 +295,9 @@ func mapRowToStruct(
 	return
 }
 
+// mapRowToDestinationValue maps row into dest value. If dest is nil pointer, new destination is allocated and assigned
+// only if at least one of its fields is updated, so nested one-to-one struct pointer stays nil when all of its
+// columns are NULL (for instance on outer join miss).
 func mapRowToDestinationValue(
 	scanContext *ScanContext,
 	groupKey string,
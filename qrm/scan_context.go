@@ -12,6 +12,7 @@ import (
 type ScanContext struct {
 	rowNum                   int64
 	row                      []interface{}
+	aliases                  []string
 	uniqueDestObjectsMap     map[string]int
 	commonIdentToColumnIndex map[string]int
 	groupKeyInfoCache        map[string]groupKeyInfo
@@ -49,6 +50,7 @@ func NewScanContext(rows *sql.Rows) (*ScanContext, error) {
 
 	return &ScanContext{
 		row:                  createScanSlice(len(columnTypes)),
+		aliases:              aliases,
 		uniqueDestObjectsMap: make(map[string]int),
 
 		groupKeyInfoCache:        make(map[string]groupKeyInfo),
@@ -79,6 +81,7 @@ type fieldMapping struct {
 	complexType       bool // slice and struct are complex types
 	rowIndex          int  // index in ScanContext.row
 	implementsScanner bool
+	jsonType          bool // complex type unmarshaled from json column
 }
 
 func (s *ScanContext) getTypeInfo(structType reflect.Type, parentField *reflect.StructField) typeInfo {
@@ -109,6 +112,8 @@ func (s *ScanContext) getTypeInfo(structType reflect.Type, parentField *reflect.
 
 		if implementsScannerType(field.Type) {
 			fieldMap.implementsScanner = true
+		} else if isJSONField(field, columnIndex) {
+			fieldMap.jsonType = true
 		} else if !isSimpleModelType(field.Type) {
 			fieldMap.complexType = true
 		}
@@ -188,7 +193,7 @@ func (s *ScanContext) getGroupKeyInfo(
 		fieldType := indirectType(field.Type)
 
 		if !isSimpleModelType(fieldType) {
-			if fieldType.Kind() != reflect.Struct {
+			if fieldType.Kind() != reflect.Struct || isJSONTagged(field) {
 				continue
 			}
 
@@ -240,6 +245,14 @@ func (s *ScanContext) rowElemValue(index int) reflect.Value {
 	return scannedValue.Elem().Elem() // no need to check validity of Elem, because s.row[index] always contains interface in interface
 }
 
+func (s *ScanContext) rowElemAlias(index int) string {
+	if index < 0 || index >= len(s.aliases) {
+		return ""
+	}
+
+	return s.aliases[index]
+}
+
 func (s *ScanContext) rowElemToString(index int) string {
 	value := s.rowElemValue(index)
 
@@ -2,6 +2,7 @@ package qrm
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"github.com/go-jet/jet/v2/internal/utils"
 	"github.com/go-jet/jet/v2/qrm/internal"
@@ -141,6 +142,7 @@ func initializeValueIfNilPtr(value reflect.Value) {
 var timeType = reflect.TypeOf(time.Now())
 var uuidType = reflect.TypeOf(uuid.New())
 var byteArrayType = reflect.TypeOf([]byte(""))
+var durationType = reflect.TypeOf(time.Duration(0))
 
 func isSimpleModelType(objType reflect.Type) bool {
 	objType = indirectType(objType)
@@ -157,6 +159,52 @@ func isSimpleModelType(objType reflect.Type) bool {
 	return objType == timeType || objType == uuidType || objType == byteArrayType
 }
 
+// isJSONTagged returns true if field is explicitly marked with `jet:"json"` tag
+func isJSONTagged(field reflect.StructField) bool {
+	return field.Tag.Get("jet") == "json"
+}
+
+// isJSONField returns true if field value should be unmarshaled from json column. Struct, map and slice of complex
+// type fields are unmarshaled from json if they are marked with `jet:"json"` tag, or if there is a column mapped
+// directly to them. Slices of simple types are not unmarshaled, unless tagged, because they collect column
+// values from multiple rows.
+func isJSONField(field reflect.StructField, columnIndex int) bool {
+	if isJSONTagged(field) {
+		return true
+	}
+
+	if columnIndex == -1 || isSimpleModelType(field.Type) {
+		return false
+	}
+
+	fieldType := indirectType(field.Type)
+
+	switch fieldType.Kind() {
+	case reflect.Struct, reflect.Map:
+		return true
+	case reflect.Slice:
+		return !isSimpleModelType(fieldType.Elem())
+	}
+
+	return false
+}
+
+// source can't be pointer, destination has to be addressable
+func unmarshalJSON(source, destination reflect.Value) error {
+	var data []byte
+
+	switch value := source.Interface().(type) {
+	case []byte:
+		data = value
+	case string:
+		data = []byte(value)
+	default:
+		return fmt.Errorf("can't unmarshal json from %T", value)
+	}
+
+	return json.Unmarshal(data, destination.Addr().Interface())
+}
+
 // source can't be pointer
 // destination can be pointer
 func assign(source, destination reflect.Value) error {
@@ -206,6 +254,22 @@ func tryAssign(source, destination reflect.Value) error {
 
 	sourceInterface := source.Interface()
 
+	if destination.Type() == durationType {
+		var nullDuration internal.NullDuration
+
+		err := nullDuration.Scan(sourceInterface)
+
+		if err != nil {
+			return err
+		}
+
+		if nullDuration.Valid {
+			destination.SetInt(int64(nullDuration.Duration))
+		}
+
+		return nil
+	}
+
 	switch destination.Type().Kind() {
 	case reflect.Bool:
 		var nullBool internal.NullBool
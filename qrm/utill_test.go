@@ -1,6 +1,7 @@
 package qrm
 
 import (
+	"database/sql"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"reflect"
@@ -79,3 +80,183 @@ func TestTryAssign(t *testing.T) {
 	require.NoError(t, tryAssign(reflect.ValueOf(str), testValue.FieldByName("Str")))
 	require.Equal(t, str, destination.Str)
 }
+
+func TestTryAssignDuration(t *testing.T) {
+	var duration time.Duration
+	destination := reflect.ValueOf(&duration).Elem()
+
+	require.NoError(t, tryAssign(reflect.ValueOf("01:30:00.5"), destination))
+	require.Equal(t, 90*time.Minute+500*time.Millisecond, duration)
+	require.NoError(t, tryAssign(reflect.ValueOf([]byte("-00:00:01")), destination))
+	require.Equal(t, -time.Second, duration)
+	require.NoError(t, tryAssign(reflect.ValueOf(int64(time.Hour)), destination))
+	require.Equal(t, time.Hour, duration)
+
+	require.EqualError(t, tryAssign(reflect.ValueOf("1 day 02:00:00"), destination),
+		`can't scan time.Duration from interval "1 day 02:00:00" with months or days`)
+}
+
+func TestIsJSONField(t *testing.T) {
+	type Info struct {
+		Name string
+	}
+
+	destination := struct {
+		Info       Info
+		InfoPtr    *Info
+		Tags       map[string]string
+		Items      []Info
+		IDs        []int32
+		TaggedIDs  []int32 `jet:"json"`
+		Name       string
+		TaggedInfo Info `jet:"json"`
+	}{}
+
+	fieldByName := func(name string) reflect.StructField {
+		field, _ := reflect.TypeOf(destination).FieldByName(name)
+		return field
+	}
+
+	require.True(t, isJSONField(fieldByName("Info"), 0))
+	require.True(t, isJSONField(fieldByName("InfoPtr"), 0))
+	require.True(t, isJSONField(fieldByName("Tags"), 0))
+	require.True(t, isJSONField(fieldByName("Items"), 0))
+	require.False(t, isJSONField(fieldByName("IDs"), 0))
+	require.True(t, isJSONField(fieldByName("TaggedIDs"), 0))
+	require.False(t, isJSONField(fieldByName("Name"), 0))
+
+	require.False(t, isJSONField(fieldByName("Info"), -1))
+	require.True(t, isJSONField(fieldByName("TaggedInfo"), -1))
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	type Info struct {
+		Name string
+		Age  int
+	}
+
+	destination := struct {
+		Info    Info
+		InfoPtr *Info
+		Tags    map[string]string
+	}{}
+
+	testValue := reflect.ValueOf(&destination).Elem()
+
+	require.NoError(t, unmarshalJSON(reflect.ValueOf([]byte(`{"Name": "John", "Age": 30}`)), testValue.FieldByName("Info")))
+	require.Equal(t, Info{Name: "John", Age: 30}, destination.Info)
+
+	require.NoError(t, unmarshalJSON(reflect.ValueOf(`{"Name": "Mike"}`), testValue.FieldByName("InfoPtr")))
+	require.Equal(t, &Info{Name: "Mike"}, destination.InfoPtr)
+
+	require.NoError(t, unmarshalJSON(reflect.ValueOf(`{"a": "b"}`), testValue.FieldByName("Tags")))
+	require.Equal(t, map[string]string{"a": "b"}, destination.Tags)
+
+	require.EqualError(t, unmarshalJSON(reflect.ValueOf(int64(11)), testValue.FieldByName("Info")), "can't unmarshal json from int64")
+	require.Error(t, unmarshalJSON(reflect.ValueOf(`{"Name": 11}`), testValue.FieldByName("Info")))
+}
+
+func TestMapRowToStructJSONColumn(t *testing.T) {
+	type Info struct {
+		Name string
+	}
+
+	type Dest struct {
+		ID   int64 `sql:"primary_key"`
+		Info *Info
+		Tags []string `jet:"json"`
+	}
+
+	id, info, tags := interface{}(int64(1)), interface{}([]byte(`{"Name": "John"}`)), interface{}(`["a", "b"]`)
+
+	scanContext := &ScanContext{
+		row:                      []interface{}{&id, &info, &tags},
+		aliases:                  []string{"dest.id", "dest.info", "dest.tags"},
+		uniqueDestObjectsMap:     map[string]int{},
+		commonIdentToColumnIndex: map[string]int{"dest.id": 0, "dest.info": 1, "dest.tags": 2},
+		groupKeyInfoCache:        map[string]groupKeyInfo{},
+		typeInfoMap:              map[string]typeInfo{},
+		typesVisited:             newTypeStack(),
+	}
+
+	var dest Dest
+
+	_, err := mapRowToStruct(scanContext, "", reflect.ValueOf(&dest), nil)
+	require.NoError(t, err)
+	require.Equal(t, Dest{ID: 1, Info: &Info{Name: "John"}, Tags: []string{"a", "b"}}, dest)
+}
+
+func TestMapRowToStructNullValues(t *testing.T) {
+	type Dest struct {
+		ID         int64 `sql:"primary_key"`
+		Ptr        *string
+		NullString sql.NullString
+		NullInt    sql.NullInt64
+		Zero       int32
+	}
+
+	id, ptr, nullString, nullInt, zero := interface{}(int64(1)), interface{}(nil), interface{}(nil), interface{}(nil), interface{}(nil)
+
+	scanContext := &ScanContext{
+		row:                      []interface{}{&id, &ptr, &nullString, &nullInt, &zero},
+		aliases:                  []string{"dest.id", "dest.ptr", "dest.null_string", "dest.null_int", "dest.zero"},
+		uniqueDestObjectsMap:     map[string]int{},
+		commonIdentToColumnIndex: map[string]int{"dest.id": 0, "dest.ptr": 1, "dest.nullstring": 2, "dest.nullint": 3, "dest.zero": 4},
+		groupKeyInfoCache:        map[string]groupKeyInfo{},
+		typeInfoMap:              map[string]typeInfo{},
+		typesVisited:             newTypeStack(),
+	}
+
+	dest := Dest{Zero: 10}
+
+	_, err := mapRowToStruct(scanContext, "", reflect.ValueOf(&dest), nil)
+	require.NoError(t, err)
+	require.Equal(t, Dest{ID: 1}, dest)
+}
+
+func TestMapRowToStructOneToOne(t *testing.T) {
+	type User struct {
+		ID   int64 `sql:"primary_key"`
+		Name string
+	}
+
+	type Profile struct {
+		UserID int64 `sql:"primary_key"`
+		Bio    string
+	}
+
+	type Dest struct {
+		User
+		Profile *Profile
+	}
+
+	newScanContext := func(row ...interface{}) *ScanContext {
+		var rowPtrs []interface{}
+		for i := range row {
+			rowPtrs = append(rowPtrs, &row[i])
+		}
+
+		return &ScanContext{
+			row:                      rowPtrs,
+			aliases:                  []string{"user.id", "user.name", "profile.user_id", "profile.bio"},
+			uniqueDestObjectsMap:     map[string]int{},
+			commonIdentToColumnIndex: map[string]int{"user.id": 0, "user.name": 1, "profile.userid": 2, "profile.bio": 3},
+			groupKeyInfoCache:        map[string]groupKeyInfo{},
+			typeInfoMap:              map[string]typeInfo{},
+			typesVisited:             newTypeStack(),
+		}
+	}
+
+	var dest Dest
+
+	_, err := mapRowToStruct(newScanContext(int64(1), "John", int64(1), "Gopher"), "", reflect.ValueOf(&dest), nil)
+	require.NoError(t, err)
+	require.Equal(t, Dest{User: User{ID: 1, Name: "John"}, Profile: &Profile{UserID: 1, Bio: "Gopher"}}, dest)
+
+	// outer join miss, all profile columns are NULL
+	dest = Dest{}
+	_, err = mapRowToStruct(newScanContext(int64(2), "Mike", nil, nil), "", reflect.ValueOf(&dest), nil)
+	require.NoError(t, err)
+	require.Equal(t, Dest{User: User{ID: 2, Name: "Mike"}}, dest)
+	require.Nil(t, dest.Profile)
+}
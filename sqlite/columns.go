@@ -8,6 +8,28 @@ type Column = jet.ColumnExpression
 // ColumnList function returns list of columns that be used as projection or column list for UPDATE and INSERT statement.
 type ColumnList = jet.ColumnList
 
+// ColumnsOf returns projection list of table columns matching model struct fields, so that projection list can
+// not drift out of sync with query result destination. Panics if some of the model fields has no matching column.
+var ColumnsOf = jet.ColumnsOf
+
+// ColumnsByName returns list of table columns with column names, or an error wrapping ErrUnknownColumn if table
+// does not have a column with some of the names. Can be used to build dynamic projection lists safely.
+var ColumnsByName = jet.ColumnsByName
+
+// ErrUnknownColumn is returned by ColumnsByName when table does not have a column with requested name
+var ErrUnknownColumn = jet.ErrUnknownColumn
+
+// SetGenerated marks column as generated (GENERATED ALWAYS identity or computed column).
+// Values can not be assigned to generated columns in INSERT and UPDATE statements.
+var SetGenerated = jet.SetGenerated
+
+// SetHasDefault marks column as having database default value. Nil pointer model values of such columns can be
+// omitted from INSERT statement with ColumnList.ExceptZeroDefaults.
+var SetHasDefault = jet.SetHasDefault
+
+// NewExpressionColumn creates new named column, whose value is computed by expression.
+var NewExpressionColumn = jet.NewExpressionColumn
+
 // ColumnBool is interface for SQL boolean columns.
 type ColumnBool = jet.ColumnBool
 
@@ -23,6 +23,8 @@ func newDialect() jet.Dialect {
 			return "?"
 		},
 		ReservedWords: reservedWords2,
+		MaxParameters: 32766,
+		OrderByAlias:  true,
 	}
 
 	return jet.NewDialect(mySQLDialectParams)
@@ -82,6 +82,9 @@ var AVG = jet.AVG
 // COUNT is aggregate function. Returns number of input rows for which the value of expression is not null.
 var COUNT = jet.COUNT
 
+// COUNT_STAR is aggregate function. Returns number of all input rows, COUNT(*).
+var COUNT_STAR = jet.COUNT_STAR
+
 // MAX is aggregate function. Returns maximum value of expression across all input values
 var MAX = jet.MAX
 
@@ -175,6 +178,10 @@ var LTRIM = jet.LTRIM
 // from characters (a space by default) from the end of string
 var RTRIM = jet.RTRIM
 
+// EscapeLike escapes LIKE wildcards('%' and '_') and escape character in value, so value can be matched literally
+// inside pattern with ESCAPE '\' clause. StringExpression CONTAINS escapes text the same way.
+var EscapeLike = jet.EscapeLike
+
 // CONCAT adds two or more expressions together
 //var CONCAT = jet.CONCAT
 
@@ -30,7 +30,8 @@ func newInsertStatement(table Table, columns []jet.Column) InsertStatement {
 	)
 
 	newInsert.Insert.Table = table
-	newInsert.Insert.Columns = columns
+	newInsert.Insert.SetColumns(columns)
+	newInsert.ValuesQuery.DefaultRow = "DEFAULT VALUES"
 	newInsert.ValuesQuery.SkipSelectWrap = true
 
 	return newInsert
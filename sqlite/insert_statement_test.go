@@ -121,6 +121,12 @@ func TestInsertFromNonStructModel(t *testing.T) {
 	table2.INSERT(table2ColInt).MODEL([]int{})
 }
 
+func TestInsert_ON_CONFLICT_DO_UPDATE_NoAssignments(t *testing.T) {
+	assertPanicErr(t, func() {
+		table1.INSERT(table1Col1).VALUES(1).ON_CONFLICT(table1Col1).DO_UPDATE(SET())
+	}, "jet: SET conflict action requires at least one column assignment")
+}
+
 func TestInsert_ON_CONFLICT(t *testing.T) {
 	stmt := table1.INSERT(table1Col1, table1ColBool).
 		VALUES("one", "two").
@@ -148,3 +154,31 @@ RETURNING table1.col1 AS "table1.col1",
           table1.col_bool AS "table1.col_bool";
 `)
 }
+
+func TestInsertValuesFromModelExceptZeroDefaults(t *testing.T) {
+	var (
+		nameColumn   = StringColumn("name")
+		activeColumn = BoolColumn("active")
+		statusColumn = StringColumn("status")
+	)
+	SetHasDefault(activeColumn)
+	SetHasDefault(statusColumn)
+
+	jobs := NewTable("db", "jobs", "", nameColumn, activeColumn, statusColumn)
+
+	type Jobs struct {
+		Name   string
+		Active bool
+		Status *string
+	}
+
+	assertStatementSql(t, jobs.INSERT(ColumnList{nameColumn, activeColumn, statusColumn}.ExceptZeroDefaults(Jobs{})).MODEL(Jobs{}), `
+INSERT INTO db.jobs (name, active)
+VALUES (?, ?);
+`, "", false)
+
+	assertStatementSql(t, jobs.INSERT(ColumnList{statusColumn}.ExceptZeroDefaults(Jobs{})).MODEL(Jobs{}), `
+INSERT INTO db.jobs
+DEFAULT VALUES;
+`)
+}
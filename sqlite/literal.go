@@ -50,6 +50,18 @@ var Decimal = jet.Decimal
 // String creates new string literal expression
 var String = jet.String
 
+// StringPtr creates new string literal expression from pointer. Nil pointer is bound as NULL.
+var StringPtr = jet.StringPtr
+
+// IntPtr creates new 64 bit signed integer literal expression from pointer. Nil pointer is bound as NULL.
+var IntPtr = jet.IntPtr
+
+// FloatPtr creates new float literal expression from float64 pointer. Nil pointer is bound as NULL.
+var FloatPtr = jet.FloatPtr
+
+// BoolPtr creates new bool literal expression from pointer. Nil pointer is bound as NULL.
+var BoolPtr = jet.BoolPtr
+
 // UUID is a helper function to create string literal expression from uuid object
 // value can be any uuid type with a String method
 var UUID = jet.UUID
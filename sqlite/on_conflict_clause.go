@@ -61,8 +61,12 @@ type conflictAction interface {
 	WHERE(condition BoolExpression) conflictAction
 }
 
-// SET creates conflict action for ON_CONFLICT clause
+// SET creates conflict action for ON_CONFLICT clause. Panics if there are no column assignments.
 func SET(assigments ...ColumnAssigment) conflictAction {
+	if len(assigments) == 0 {
+		panic("jet: SET conflict action requires at least one column assignment")
+	}
+
 	conflictAction := updateConflictActionImpl{}
 	conflictAction.doUpdate = jet.KeywordClause{Keyword: "DO UPDATE"}
 	conflictAction.Serializer = jet.NewSerializerClauseImpl(&conflictAction.doUpdate, &conflictAction.set, &conflictAction.where)
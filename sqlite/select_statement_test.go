@@ -154,3 +154,65 @@ WHERE NOT (EXISTS (
       ));
 `)
 }
+
+func TestExistsCount(t *testing.T) {
+	assertStatementSql(t, ExistsCount(table1, table1ColInt.EQ(Int(11))), `
+SELECT (EXISTS (
+          SELECT 1
+          FROM db.table1
+          WHERE table1.col_int = ?
+     )) AS "exists";
+`, int64(11))
+}
+
+func TestSelectFilter(t *testing.T) {
+	stmt := SELECT(table1ColInt).FROM(table1)
+	rowNumber := ROW_NUMBER().OVER(PARTITION_BY(table1ColInt).ORDER_BY(table1ColFloat))
+	first := func(window Expression) BoolExpression {
+		return IntExp(window).EQ(Int(1))
+	}
+
+	expectedSQL := `
+SELECT filtered.` + "`table1.col_int`" + ` AS "table1.col_int"
+FROM (
+          SELECT table1.col_int AS "table1.col_int",
+               ROW_NUMBER() OVER (PARTITION BY table1.col_int ORDER BY table1.col_float) AS "filter_window"
+          FROM db.table1
+     ) AS filtered
+WHERE filtered.filter_window = ?;
+`
+	assertStatementSql(t, stmt.Filter(rowNumber, first), expectedSQL, int64(1))
+	assertStatementSql(t, stmt.Filter(rowNumber, first), expectedSQL, int64(1))
+	assertStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1;
+`)
+}
+
+func TestSelectFilterOrderByLimit(t *testing.T) {
+	stmt := SELECT(table1ColInt, table1ColFloat).
+		FROM(table1).
+		ORDER_BY(table1ColInt, table1ColFloat.DESC()).
+		LIMIT(5).
+		OFFSET(10).
+		Filter(ROW_NUMBER().OVER(PARTITION_BY(table1ColInt).ORDER_BY(table1ColFloat.DESC())),
+			func(window Expression) BoolExpression {
+				return IntExp(window).EQ(Int(1))
+			})
+
+	assertStatementSql(t, stmt, `
+SELECT filtered.`+"`table1.col_int`"+` AS "table1.col_int",
+     filtered.`+"`table1.col_float`"+` AS "table1.col_float"
+FROM (
+          SELECT table1.col_int AS "table1.col_int",
+               table1.col_float AS "table1.col_float",
+               ROW_NUMBER() OVER (PARTITION BY table1.col_int ORDER BY table1.col_float DESC) AS "filter_window",
+               ROW_NUMBER() OVER (ORDER BY table1.col_int, table1.col_float DESC) AS "filter_order"
+          FROM db.table1
+     ) AS filtered
+WHERE filtered.filter_window = ?
+ORDER BY filter_order ASC
+LIMIT ?
+OFFSET ?;
+`, int64(1), int64(5), int64(10))
+}
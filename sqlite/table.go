@@ -10,6 +10,18 @@ type Table interface {
 	INSERT(columns ...jet.Column) InsertStatement
 	UPDATE(columns ...jet.Column) UpdateStatement
 	DELETE() DeleteStatement
+
+	// Projection defines named projection set of table columns, if columns are passed, or returns previously defined
+	// projection set otherwise. Panics if projection set is not defined.
+	Projection(name string, columns ...jet.Column) ColumnList
+
+	// Column returns table column with the name, as it was declared in the table. If table does not have the column,
+	// statement using returned column panics when serialized.
+	Column(name string) Column
+	// ColumnOrError returns table column with the name, or an error if table does not have the column.
+	ColumnOrError(name string) (Column, error)
+	// Projections returns list of all the table columns, in the order they are declared in the table
+	Projections() ProjectionList
 }
 
 type readableTable interface {
@@ -30,6 +42,13 @@ type readableTable interface {
 
 	// Creates a cross join tableName Expression using onCondition.
 	CROSS_JOIN(table ReadableTable) joinSelectUpdateTable
+
+	// Creates a natural join tableName Expression, which joins tables on all the columns with the same name.
+	NATURAL_JOIN(table ReadableTable) joinSelectUpdateTable
+
+	// Creates a join tableName Expression on equality of listed columns, which have the same name in both tables:
+	// JOIN table USING (column1, column2). Panics if columns list is empty.
+	JOIN_USING(table ReadableTable, columns ...jet.Column) joinSelectUpdateTable
 }
 
 type joinSelectUpdateTable interface {
@@ -75,10 +94,19 @@ func (r readableTableInterfaceImpl) CROSS_JOIN(table ReadableTable) joinSelectUp
 	return newJoinTable(r.parent, table, jet.CrossJoin, nil)
 }
 
+func (r readableTableInterfaceImpl) NATURAL_JOIN(table ReadableTable) joinSelectUpdateTable {
+	return newJoinTable(r.parent, table, jet.NaturalJoin, nil)
+}
+
+func (r readableTableInterfaceImpl) JOIN_USING(table ReadableTable, columns ...jet.Column) joinSelectUpdateTable {
+	return wrapJoinTable(jet.NewJoinTableUsing(r.parent, table, jet.UnwidColumnList(columns)))
+}
+
 // NewTable creates new table with schema Name, table Name and list of columns
 func NewTable(schemaName, name, alias string, columns ...jet.ColumnExpression) Table {
 	t := &tableImpl{
 		SerializerTable: jet.NewTable(schemaName, name, alias, columns...),
+		projectionSets:  &jet.ProjectionSets{},
 	}
 
 	t.readableTableInterfaceImpl.parent = t
@@ -91,10 +119,12 @@ type tableImpl struct {
 	jet.SerializerTable
 	readableTableInterfaceImpl
 	parent Table
+
+	projectionSets *jet.ProjectionSets
 }
 
 func (t *tableImpl) INSERT(columns ...jet.Column) InsertStatement {
-	return newInsertStatement(t.parent, jet.UnwidColumnList(columns))
+	return newInsertStatement(t.parent, columns)
 }
 
 func (t *tableImpl) UPDATE(columns ...jet.Column) UpdateStatement {
@@ -105,14 +135,36 @@ func (t *tableImpl) DELETE() DeleteStatement {
 	return newDeleteStatement(t.parent)
 }
 
+// Projection defines named projection set of table columns, if columns are passed, or returns previously defined
+// projection set otherwise.
+func (t *tableImpl) Projection(name string, columns ...jet.Column) ColumnList {
+	return t.projectionSets.Projection(t, name, columns...)
+}
+
+func (t *tableImpl) Column(name string) Column {
+	return jet.TableColumn(t.parent, name)
+}
+
+func (t *tableImpl) ColumnOrError(name string) (Column, error) {
+	return jet.LookupColumn(t.parent, name)
+}
+
+func (t *tableImpl) Projections() ProjectionList {
+	return jet.TableProjections(t.parent)
+}
+
 type joinTable struct {
 	tableImpl
 	jet.JoinTable
 }
 
 func newJoinTable(lhs jet.Serializer, rhs jet.Serializer, joinType jet.JoinType, onCondition BoolExpression) Table {
+	return wrapJoinTable(jet.NewJoinTable(lhs, rhs, joinType, onCondition))
+}
+
+func wrapJoinTable(table jet.JoinTable) Table {
 	newJoinTable := &joinTable{
-		JoinTable: jet.NewJoinTable(lhs, rhs, joinType, onCondition),
+		JoinTable: table,
 	}
 
 	newJoinTable.readableTableInterfaceImpl.parent = newJoinTable
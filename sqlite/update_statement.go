@@ -11,6 +11,9 @@ type UpdateStatement interface {
 
 	FROM(tables ...ReadableTable) UpdateStatement
 	WHERE(expression BoolExpression) UpdateStatement
+	// AllRows explicitly allows statement without WHERE clause, which updates all table rows. Statement without
+	// WHERE clause, that is not marked with AllRows, can not be serialized or executed.
+	AllRows() UpdateStatement
 	RETURNING(projections ...Projection) UpdateStatement
 }
 
@@ -72,6 +75,11 @@ func (u *updateStatementImpl) WHERE(expression BoolExpression) UpdateStatement {
 	return u
 }
 
+func (u *updateStatementImpl) AllRows() UpdateStatement {
+	u.Where.Mandatory = false
+	return u
+}
+
 func (u *updateStatementImpl) RETURNING(projections ...Projection) UpdateStatement {
 	u.Returning.ProjectionList = projections
 	return u
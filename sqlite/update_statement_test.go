@@ -80,3 +80,10 @@ func TestInvalidInputs(t *testing.T) {
 	assertStatementSqlErr(t, table1.UPDATE(table1ColInt).SET(1), "jet: WHERE clause not set")
 	assertStatementSqlErr(t, table1.UPDATE(nil).SET(1), "jet: nil column in columns list for SET clause")
 }
+
+func TestUpdateAllRows(t *testing.T) {
+	assertStatementSql(t, table1.UPDATE(table1ColInt).SET(1).AllRows(), `
+UPDATE db.table1
+SET col_int = ?;
+`, 1)
+}
@@ -8,6 +8,11 @@ type CommonTableExpression interface {
 
 	AS(statement jet.SerializerStatement) CommonTableExpression
 	AS_NOT_MATERIALIZED(statement jet.SerializerStatement) CommonTableExpression
+	AS_MATERIALIZED(statement jet.SerializerStatement) CommonTableExpression
+	// Materialized hints database to compute CTE query only once, serialized as: name AS MATERIALIZED (...)
+	Materialized() CommonTableExpression
+	// NotMaterialized hints database to fold CTE query into the main query, serialized as: name AS NOT MATERIALIZED (...)
+	NotMaterialized() CommonTableExpression
 	// ALIAS is used to create another alias of the CTE, if a CTE needs to appear multiple times in the main query.
 	ALIAS(alias string) SelectTable
 
@@ -19,7 +24,8 @@ type commonTableExpression struct {
 	jet.CommonTableExpression
 }
 
-// WITH function creates new WITH statement from list of common table expressions
+// WITH function creates new WITH statement from list of common table expressions. Statement is serialized as
+// WITH RECURSIVE if any of the common table expressions references itself.
 func WITH(cte ...CommonTableExpression) func(statement jet.Statement) Statement {
 	return jet.WITH(Dialect, false, toInternalCTE(cte)...)
 }
@@ -49,8 +55,25 @@ func (c *commonTableExpression) AS(statement jet.SerializerStatement) CommonTabl
 
 // AS_NOT_MATERIALIZED is used to define not materialized CTE query
 func (c *commonTableExpression) AS_NOT_MATERIALIZED(statement jet.SerializerStatement) CommonTableExpression {
-	c.CommonTableExpression.NotMaterialized = true
 	c.CommonTableExpression.Statement = statement
+	return c.NotMaterialized()
+}
+
+// AS_MATERIALIZED is used to define materialized CTE query
+func (c *commonTableExpression) AS_MATERIALIZED(statement jet.SerializerStatement) CommonTableExpression {
+	c.CommonTableExpression.Statement = statement
+	return c.Materialized()
+}
+
+func (c *commonTableExpression) Materialized() CommonTableExpression {
+	c.CommonTableExpression.Materialized = true
+	c.CommonTableExpression.NotMaterialized = false
+	return c
+}
+
+func (c *commonTableExpression) NotMaterialized() CommonTableExpression {
+	c.CommonTableExpression.NotMaterialized = true
+	c.CommonTableExpression.Materialized = false
 	return c
 }
 
@@ -224,6 +224,19 @@ type ActorTable struct {
 
 	AllColumns     mysql.ColumnList
 	MutableColumns mysql.ColumnList
+	DefaultColumns mysql.ColumnList
+}
+
+// INSERT_MODEL creates new INSERT statement for all mutable columns, with values taken from the model.
+// Model fields are matched with columns using the same rules as query result mapping.
+// Columns with database default value are omitted if corresponding model field is nil pointer.
+func (a ActorTable) INSERT_MODEL(model interface{}) mysql.InsertStatement {
+	return a.INSERT(a.MutableColumns.ExceptZeroDefaults(model)).MODEL(model)
+}
+
+// InsertOrIgnore creates new INSERT IGNORE statement from the model, which skips the row if it conflicts with existing one.
+func (a ActorTable) InsertOrIgnore(model interface{}) mysql.InsertStatement {
+	return a.INSERT_MODEL(model).IGNORE()
 }
 
 // AS creates new ActorTable with assigned alias
@@ -236,6 +249,11 @@ func (a ActorTable) FromSchema(schemaName string) ActorTable {
 	return newActorTable(schemaName, a.TableName(), a.Alias())
 }
 
+// PrimaryKey returns list of ActorTable primary key columns
+func (a ActorTable) PrimaryKey() mysql.ColumnList {
+	return mysql.ColumnList{a.ActorID}
+}
+
 func newActorTable(schemaName, tableName, alias string) ActorTable {
 	var (
 		ActorIDColumn    = mysql.IntegerColumn("actor_id")
@@ -244,7 +262,10 @@ func newActorTable(schemaName, tableName, alias string) ActorTable {
 		LastUpdateColumn = mysql.TimestampColumn("last_update")
 		allColumns       = mysql.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
 		mutableColumns   = mysql.ColumnList{FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		defaultColumns   = mysql.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
 	)
+	mysql.SetHasDefault(ActorIDColumn)
+	mysql.SetHasDefault(LastUpdateColumn)
 
 	return ActorTable{
 		Table: mysql.NewTable(schemaName, tableName, alias, allColumns...),
@@ -257,6 +278,7 @@ func newActorTable(schemaName, tableName, alias string) ActorTable {
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
 	}
 }
 `
@@ -310,6 +332,7 @@ type ActorInfoTable struct {
 
 	AllColumns     mysql.ColumnList
 	MutableColumns mysql.ColumnList
+	DefaultColumns mysql.ColumnList
 }
 
 // AS creates new ActorInfoTable with assigned alias
@@ -330,6 +353,7 @@ func newActorInfoTable(schemaName, tableName, alias string) ActorInfoTable {
 		FilmInfoColumn  = mysql.StringColumn("film_info")
 		allColumns      = mysql.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
 		mutableColumns  = mysql.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
+		defaultColumns  = mysql.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
 	)
 
 	return ActorInfoTable{
@@ -343,6 +367,7 @@ func newActorInfoTable(schemaName, tableName, alias string) ActorInfoTable {
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
 	}
 }
 `
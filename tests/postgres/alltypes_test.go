@@ -1134,8 +1134,8 @@ var allTypesRow0 = model.AllTypes{
 	Timez:                *testutils.TimeWithTimeZone("04:05:06 -0800"),
 	TimePtr:              testutils.TimeWithoutTimeZone("04:05:06"),
 	Time:                 *testutils.TimeWithoutTimeZone("04:05:06"),
-	IntervalPtr:          testutils.StringPtr("3 days 04:05:06"),
-	Interval:             "3 days 04:05:06",
+	IntervalPtr:          &Interval{Days: 3, Microseconds: 14706000000},
+	Interval:             Interval{Days: 3, Microseconds: 14706000000},
 	BooleanPtr:           testutils.BoolPtr(true),
 	Boolean:              false,
 	PointPtr:             testutils.StringPtr("(2,3)"),
@@ -1201,7 +1201,7 @@ var allTypesRow1 = model.AllTypes{
 	TimePtr:              nil,
 	Time:                 *testutils.TimeWithoutTimeZone("04:05:06"),
 	IntervalPtr:          nil,
-	Interval:             "3 days 04:05:06",
+	Interval:             Interval{Days: 3, Microseconds: 14706000000},
 	BooleanPtr:           nil,
 	Boolean:              false,
 	PointPtr:             nil,
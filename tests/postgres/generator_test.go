@@ -295,6 +295,7 @@ type actorTable struct {
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
+	DefaultColumns postgres.ColumnList
 }
 
 type ActorTable struct {
@@ -303,6 +304,20 @@ type ActorTable struct {
 	EXCLUDED actorTable
 }
 
+// INSERT_MODEL creates new INSERT statement for all mutable columns, with values taken from the model.
+// Model fields are matched with columns using the same rules as query result mapping.
+// Columns with database default value are omitted if corresponding model field is nil pointer.
+// Primary key values generated by database are returned with RETURNING clause, and can be scanned back into the model.
+func (a ActorTable) INSERT_MODEL(model interface{}) postgres.InsertStatement {
+	return a.INSERT(a.MutableColumns.ExceptZeroDefaults(model)).MODEL(model).
+		RETURNING(a.ActorID)
+}
+
+// InsertOrIgnore creates new INSERT statement from the model, which does nothing if the row conflicts with existing one on primary key.
+func (a ActorTable) InsertOrIgnore(model interface{}) postgres.InsertStatement {
+	return a.INSERT_MODEL(model).ON_CONFLICT(a.PrimaryKey()...).DO_NOTHING()
+}
+
 // AS creates new ActorTable with assigned alias
 func (a ActorTable) AS(alias string) *ActorTable {
 	return newActorTable(a.SchemaName(), a.TableName(), alias)
@@ -313,6 +328,11 @@ func (a ActorTable) FromSchema(schemaName string) *ActorTable {
 	return newActorTable(schemaName, a.TableName(), a.Alias())
 }
 
+// PrimaryKey returns list of ActorTable primary key columns
+func (a ActorTable) PrimaryKey() postgres.ColumnList {
+	return postgres.ColumnList{a.ActorID}
+}
+
 func newActorTable(schemaName, tableName, alias string) *ActorTable {
 	return &ActorTable{
 		actorTable: newActorTableImpl(schemaName, tableName, alias),
@@ -328,7 +348,10 @@ func newActorTableImpl(schemaName, tableName, alias string) actorTable {
 		LastUpdateColumn = postgres.TimestampColumn("last_update")
 		allColumns       = postgres.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
 		mutableColumns   = postgres.ColumnList{FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		defaultColumns   = postgres.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
 	)
+	postgres.SetHasDefault(ActorIDColumn)
+	postgres.SetHasDefault(LastUpdateColumn)
 
 	return actorTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
@@ -341,6 +364,7 @@ func newActorTableImpl(schemaName, tableName, alias string) actorTable {
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
 	}
 }
 `
@@ -394,6 +418,7 @@ type actorInfoTable struct {
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
+	DefaultColumns postgres.ColumnList
 }
 
 type ActorInfoTable struct {
@@ -427,6 +452,7 @@ func newActorInfoTableImpl(schemaName, tableName, alias string) actorInfoTable {
 		FilmInfoColumn  = postgres.StringColumn("film_info")
 		allColumns      = postgres.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
 		mutableColumns  = postgres.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
+		defaultColumns  = postgres.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
 	)
 
 	return actorInfoTable{
@@ -440,6 +466,7 @@ func newActorInfoTableImpl(schemaName, tableName, alias string) actorInfoTable {
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
 	}
 }
 `
@@ -534,6 +561,7 @@ var allTypesModelContent = `
 package model
 
 import (
+	"github.com/go-jet/jet/v2/postgres"
 	"github.com/google/uuid"
 	"time"
 )
@@ -574,8 +602,8 @@ type AllTypes struct {
 	Timez                time.Time
 	TimePtr              *time.Time
 	Time                 time.Time
-	IntervalPtr          *string
-	Interval             string
+	IntervalPtr          *postgres.Interval
+	Interval             postgres.Interval
 	BooleanPtr           *bool
 	Boolean              bool
 	PointPtr             *string
@@ -687,6 +715,7 @@ type allTypesTable struct {
 
 	AllColumns     postgres.ColumnList
 	MutableColumns postgres.ColumnList
+	DefaultColumns postgres.ColumnList
 }
 
 type AllTypesTable struct {
@@ -695,6 +724,13 @@ type AllTypesTable struct {
 	EXCLUDED allTypesTable
 }
 
+// INSERT_MODEL creates new INSERT statement for all mutable columns, with values taken from the model.
+// Model fields are matched with columns using the same rules as query result mapping.
+// Columns with database default value are omitted if corresponding model field is nil pointer.
+func (a AllTypesTable) INSERT_MODEL(model interface{}) postgres.InsertStatement {
+	return a.INSERT(a.MutableColumns.ExceptZeroDefaults(model)).MODEL(model)
+}
+
 // AS creates new AllTypesTable with assigned alias
 func (a AllTypesTable) AS(alias string) *AllTypesTable {
 	return newAllTypesTable(a.SchemaName(), a.TableName(), alias)
@@ -777,7 +813,11 @@ func newAllTypesTableImpl(schemaName, tableName, alias string) allTypesTable {
 		TextMultiDimArrayColumn    = postgres.StringColumn("text_multi_dim_array")
 		allColumns                 = postgres.ColumnList{SmallIntPtrColumn, SmallIntColumn, IntegerPtrColumn, IntegerColumn, BigIntPtrColumn, BigIntColumn, DecimalPtrColumn, DecimalColumn, NumericPtrColumn, NumericColumn, RealPtrColumn, RealColumn, DoublePrecisionPtrColumn, DoublePrecisionColumn, SmallserialColumn, SerialColumn, BigserialColumn, VarCharPtrColumn, VarCharColumn, CharPtrColumn, CharColumn, TextPtrColumn, TextColumn, ByteaPtrColumn, ByteaColumn, TimestampzPtrColumn, TimestampzColumn, TimestampPtrColumn, TimestampColumn, DatePtrColumn, DateColumn, TimezPtrColumn, TimezColumn, TimePtrColumn, TimeColumn, IntervalPtrColumn, IntervalColumn, BooleanPtrColumn, BooleanColumn, PointPtrColumn, BitPtrColumn, BitColumn, BitVaryingPtrColumn, BitVaryingColumn, TsvectorPtrColumn, TsvectorColumn, UUIDPtrColumn, UUIDColumn, XMLPtrColumn, XMLColumn, JSONPtrColumn, JSONColumn, JsonbPtrColumn, JsonbColumn, IntegerArrayPtrColumn, IntegerArrayColumn, TextArrayPtrColumn, TextArrayColumn, JsonbArrayColumn, TextMultiDimArrayPtrColumn, TextMultiDimArrayColumn}
 		mutableColumns             = postgres.ColumnList{SmallIntPtrColumn, SmallIntColumn, IntegerPtrColumn, IntegerColumn, BigIntPtrColumn, BigIntColumn, DecimalPtrColumn, DecimalColumn, NumericPtrColumn, NumericColumn, RealPtrColumn, RealColumn, DoublePrecisionPtrColumn, DoublePrecisionColumn, SmallserialColumn, SerialColumn, BigserialColumn, VarCharPtrColumn, VarCharColumn, CharPtrColumn, CharColumn, TextPtrColumn, TextColumn, ByteaPtrColumn, ByteaColumn, TimestampzPtrColumn, TimestampzColumn, TimestampPtrColumn, TimestampColumn, DatePtrColumn, DateColumn, TimezPtrColumn, TimezColumn, TimePtrColumn, TimeColumn, IntervalPtrColumn, IntervalColumn, BooleanPtrColumn, BooleanColumn, PointPtrColumn, BitPtrColumn, BitColumn, BitVaryingPtrColumn, BitVaryingColumn, TsvectorPtrColumn, TsvectorColumn, UUIDPtrColumn, UUIDColumn, XMLPtrColumn, XMLColumn, JSONPtrColumn, JSONColumn, JsonbPtrColumn, JsonbColumn, IntegerArrayPtrColumn, IntegerArrayColumn, TextArrayPtrColumn, TextArrayColumn, JsonbArrayColumn, TextMultiDimArrayPtrColumn, TextMultiDimArrayColumn}
+		defaultColumns             = postgres.ColumnList{SmallIntPtrColumn, SmallIntColumn, IntegerPtrColumn, IntegerColumn, BigIntPtrColumn, BigIntColumn, DecimalPtrColumn, DecimalColumn, NumericPtrColumn, NumericColumn, RealPtrColumn, RealColumn, DoublePrecisionPtrColumn, DoublePrecisionColumn, SmallserialColumn, SerialColumn, BigserialColumn, VarCharPtrColumn, VarCharColumn, CharPtrColumn, CharColumn, TextPtrColumn, TextColumn, TimestampzPtrColumn, TimestampzColumn, TimestampPtrColumn, TimestampColumn, DatePtrColumn, DateColumn, TimezPtrColumn, TimezColumn, TimePtrColumn, TimeColumn, IntervalPtrColumn, IntervalColumn, BooleanPtrColumn, BooleanColumn, PointPtrColumn, BitPtrColumn, BitColumn, BitVaryingPtrColumn, BitVaryingColumn, TsvectorPtrColumn, TsvectorColumn, UUIDPtrColumn, UUIDColumn, IntegerArrayPtrColumn, IntegerArrayColumn}
 	)
+	postgres.SetHasDefault(SmallserialColumn)
+	postgres.SetHasDefault(SerialColumn)
+	postgres.SetHasDefault(BigserialColumn)
 
 	return allTypesTable{
 		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
@@ -847,6 +887,7 @@ func newAllTypesTableImpl(schemaName, tableName, alias string) allTypesTable {
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
 	}
 }
 `
@@ -207,7 +207,7 @@ func TestScanToStruct(t *testing.T) {
 
 		err := query.Query(db, &dest)
 		require.Error(t, err)
-		require.EqualError(t, err, "jet: can't scan int64('\\x01') to 'InventoryID uuid.UUID': Scan: unable to scan type int64 into UUID")
+		require.EqualError(t, err, "jet: can't scan int64('\\x01') from column 'inventory.inventory_id' to 'InventoryID uuid.UUID': Scan: unable to scan type int64 into UUID")
 	})
 
 	t.Run("type mismatch base type", func(t *testing.T) {
@@ -220,7 +220,7 @@ func TestScanToStruct(t *testing.T) {
 
 		err := query.OFFSET(10).Query(db, &dest)
 		require.Error(t, err)
-		require.EqualError(t, err, "jet: can't assign int64('\\x02') to 'FilmID bool': can't assign int64(2) to bool")
+		require.EqualError(t, err, "jet: can't assign int64('\\x02') from column 'inventory.film_id' to 'FilmID bool': can't assign int64(2) to bool")
 	})
 }
 
@@ -936,9 +936,9 @@ func TestScanNumericToIntegerError(t *testing.T) {
 	require.Error(t, err)
 
 	if isPgxDriver() {
-		require.Contains(t, err.Error(), `jet: can't assign string("1234567890.111") to 'Integer int32': converting driver.Value type string ("1234567890.111") to a int64: invalid syntax`)
+		require.Contains(t, err.Error(), `jet: can't assign string("1234567890.111") from column 'integer' to 'Integer int32': converting driver.Value type string ("1234567890.111") to a int64: invalid syntax`)
 	} else {
-		require.Contains(t, err.Error(), `jet: can't assign []uint8("1234567890.111") to 'Integer int32': converting driver.Value type []uint8 ("1234567890.111") to a int64: invalid syntax`)
+		require.Contains(t, err.Error(), `jet: can't assign []uint8("1234567890.111") from column 'integer' to 'Integer int32': converting driver.Value type []uint8 ("1234567890.111") to a int64: invalid syntax`)
 	}
 
 }
@@ -165,6 +165,7 @@ type actorTable struct {
 
 	AllColumns     sqlite.ColumnList
 	MutableColumns sqlite.ColumnList
+	DefaultColumns sqlite.ColumnList
 }
 
 type ActorTable struct {
@@ -173,6 +174,18 @@ type ActorTable struct {
 	EXCLUDED actorTable
 }
 
+// INSERT_MODEL creates new INSERT statement for all mutable columns, with values taken from the model.
+// Model fields are matched with columns using the same rules as query result mapping.
+// Columns with database default value are omitted if corresponding model field is nil pointer.
+func (a ActorTable) INSERT_MODEL(model interface{}) sqlite.InsertStatement {
+	return a.INSERT(a.MutableColumns.ExceptZeroDefaults(model)).MODEL(model)
+}
+
+// InsertOrIgnore creates new INSERT statement from the model, which does nothing if the row conflicts with existing one on primary key.
+func (a ActorTable) InsertOrIgnore(model interface{}) sqlite.InsertStatement {
+	return a.INSERT_MODEL(model).ON_CONFLICT(a.PrimaryKey()...).DO_NOTHING()
+}
+
 // AS creates new ActorTable with assigned alias
 func (a ActorTable) AS(alias string) *ActorTable {
 	return newActorTable(a.SchemaName(), a.TableName(), alias)
@@ -183,6 +196,11 @@ func (a ActorTable) FromSchema(schemaName string) *ActorTable {
 	return newActorTable(schemaName, a.TableName(), a.Alias())
 }
 
+// PrimaryKey returns list of ActorTable primary key columns
+func (a ActorTable) PrimaryKey() sqlite.ColumnList {
+	return sqlite.ColumnList{a.ActorID}
+}
+
 func newActorTable(schemaName, tableName, alias string) *ActorTable {
 	return &ActorTable{
 		actorTable: newActorTableImpl(schemaName, tableName, alias),
@@ -198,6 +216,7 @@ func newActorTableImpl(schemaName, tableName, alias string) actorTable {
 		LastUpdateColumn = sqlite.TimestampColumn("last_update")
 		allColumns       = sqlite.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
 		mutableColumns   = sqlite.ColumnList{FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		defaultColumns   = sqlite.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
 	)
 
 	return actorTable{
@@ -211,6 +230,7 @@ func newActorTableImpl(schemaName, tableName, alias string) actorTable {
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
 	}
 }
 `
@@ -246,6 +266,7 @@ type filmListTable struct {
 
 	AllColumns     sqlite.ColumnList
 	MutableColumns sqlite.ColumnList
+	DefaultColumns sqlite.ColumnList
 }
 
 type FilmListTable struct {
@@ -283,6 +304,7 @@ func newFilmListTableImpl(schemaName, tableName, alias string) filmListTable {
 		ActorsColumn      = sqlite.StringColumn("actors")
 		allColumns        = sqlite.ColumnList{FidColumn, TitleColumn, DescriptionColumn, CategoryColumn, PriceColumn, LengthColumn, RatingColumn, ActorsColumn}
 		mutableColumns    = sqlite.ColumnList{FidColumn, TitleColumn, DescriptionColumn, CategoryColumn, PriceColumn, LengthColumn, RatingColumn, ActorsColumn}
+		defaultColumns    = sqlite.ColumnList{FidColumn, TitleColumn, DescriptionColumn, CategoryColumn, PriceColumn, LengthColumn, RatingColumn, ActorsColumn}
 	)
 
 	return filmListTable{
@@ -300,6 +322,7 @@ func newFilmListTableImpl(schemaName, tableName, alias string) filmListTable {
 
 		AllColumns:     allColumns,
 		MutableColumns: mutableColumns,
+		DefaultColumns: defaultColumns,
 	}
 }
 `